@@ -0,0 +1,217 @@
+// Package cli is the command line interface of enumer. Custom enumer binaries
+// link their plugins in and call Main:
+//
+//	import (
+//		"github.com/spectralogic/enumer/cli"
+//		_ "example.com/enumer/ourplugin" // Calls generator.RegisterPlugin
+//	)
+//
+//	func main() {
+//		cli.Main()
+//	}
+package cli
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/spectralogic/enumer/generator"
+)
+
+var opts generator.Options
+
+// defineFlags defines the flags of the built-in options and of the plugins
+// registered so far. It returns the flags that enable the plugins.
+func defineFlags() map[string]*bool {
+	optionalValueVar(&opts.SQL, &opts.SQLStorage, generator.IncludeSQL, "if set, the Scanner and Valuer interface will be implemented. The value selects how the enum is stored: \"string\" (the default) or \"int\"")
+	flag.StringVar(&opts.ORMs, generator.ORMs, "", "comma-separated list of ORMs to generate column mapping methods for: gorm, ent. Requires -sql. Default: none")
+	flag.BoolVar(&opts.JSON, generator.IncludeJSON, false, "if true, json marshaling methods will be generated. Default: false")
+	optionalValueVar(&opts.YAML, &opts.YAMLVersion, generator.IncludeYAML, "if set, yaml marshaling methods will be generated. The value selects the yaml package: \"v2\" (the default) or \"v3\"")
+	flag.BoolVar(&opts.Text, generator.IncludeText, false, "if true, text marshaling methods will be generated. Default: false")
+	optionalValueVar(&opts.Binary, &opts.BinaryEncoding, generator.IncludeBinary, "if set, binary marshaling methods will be generated. The value selects the encoding: \"varint\" (the default) or \"name\"")
+	flag.BoolVar(&opts.XML, generator.IncludeXML, false, "if true, xml element and attribute marshaling methods will be generated. Default: false")
+	flag.BoolVar(&opts.GraphQL, generator.IncludeGraphQL, false, "if true, gqlgen marshaling methods will be generated. Default: false")
+	flag.StringVar(&opts.GraphQLCase, generator.GraphQLCase, "", "GraphQL enum value name transformation method. Default: snakeu")
+	optionalValueVar(&opts.GraphQLSchema, &opts.GraphQLSchemaFile, generator.GraphQLSchema, "if set, a GraphQL schema fragment declaring the enum will be written. The value is the file name; default srcdir/<type>_string.graphqls")
+	flag.BoolVar(&opts.PGArray, generator.IncludePGArray, false, "if true, a <Type>Slice type stored as a PostgreSQL array will be generated. Default: false")
+	flag.BoolVar(&opts.Flag, generator.IncludeFlag, false, "if true, the flag.Value and pflag.Value interfaces will be implemented. Default: false")
+	flag.BoolVar(&opts.List, generator.IncludeList, false, "if true, a <Type>List type holding separated lists of values will be generated. Default: false")
+	flag.StringVar(&opts.ListSeparator, generator.ListSeparator, "", "separator of the values in a <Type>List. Default: \",\"")
+	flag.BoolVar(&opts.ListDedupe, generator.ListDedupe, false, "if true, <Type>List drops repeated values when parsing. Default: false")
+	flag.BoolVar(&opts.Completion, generator.IncludeCompletion, false, "if true, a <Type>Completions function listing shell completions will be generated. Default: false")
+	flag.StringVar(&opts.EmitShells, generator.EmitShells, "", "comma-separated list of shells to write value completion functions for: bash, zsh, fish. They are written next to the Go file as <type>_string.<shell>. Default: none")
+	flag.BoolVar(&opts.Slog, generator.IncludeSlog, false, "if true, the slog.LogValuer interface will be implemented. Default: false")
+	flag.BoolVar(&opts.SlogGroup, generator.SlogGroup, false, "if true, LogValue logs a group with the name and the number of the value. Default: false")
+	flag.BoolVar(&opts.Format, generator.IncludeFormat, false, "if true, the fmt.Formatter and fmt.GoStringer interfaces will be implemented. Default: false")
+	flag.BoolVar(&opts.Iter, generator.IncludeIter, false, "if true, <Type>All, <Type>Names and <Type>Pairs iterator functions will be generated. Default: false")
+	flag.BoolVar(&opts.ValuesCopy, generator.ValuesCopy, false, "if true, <Type>Values returns a copy of the values that the caller may modify. Default: false")
+	flag.BoolVar(&opts.Enum, generator.IncludeEnum, false, "if true, the interfaces of the github.com/spectralogic/enumer/enum package will be implemented. Default: false")
+	flag.BoolVar(&opts.Register, generator.IncludeRegister, false, "if true, the enum will be registered in the registry of the github.com/spectralogic/enumer/enum package. Default: false")
+	flag.BoolVar(&opts.Info, generator.IncludeInfo, false, "if true, a <Type>Info table describing the values, with GoName and Doc methods, will be generated. Default: false")
+	flag.BoolVar(&opts.Null, generator.IncludeNull, false, "if true, a Null<Type> wrapper with the same sql, json, text and yaml methods as the type will be generated. Default: false")
+	flag.BoolVar(&opts.IgnoreCase, generator.IgnoreCase, false, "if true, transforming from a string ignores case. Default: false")
+	flag.BoolVar(&opts.AllowNumeric, generator.AllowNumeric, false, "if true, transforming from a string allows input of numeric values. Default: false")
+	flag.BoolVar(&opts.LineComment, generator.LineComment, false, "use line comment text as printed text when present")
+	flag.StringVar(&opts.Transform, generator.TransformMethod, "", "enum item name transformation method. Default: noop")
+	flag.StringVar(&opts.TrimPrefix, generator.TrimPrefix, "", "transform each item name by removing a prefix. Default: \"\"")
+	flag.StringVar(&opts.EmptyValue, generator.EmptyValue, "", "Use an empty string for this enum value. Default: \"\"")
+	flag.StringVar(&opts.DDL, generator.DDLDialect, "", "SQL dialect of the DDL to write for the enum: postgres, mysql or sqlite. Default: no DDL")
+	flag.StringVar(&opts.DDLOutput, generator.DDLOutput, "", "DDL file name; default srcdir/<type>_string.sql")
+	flag.StringVar(&opts.DDLPrevious, generator.DDLPrevious, "", "previously generated postgres DDL file; ALTER TYPE statements adding the new values are written next to the DDL file, as <name>.alter.sql")
+	optionalValueVar(&opts.TS, &opts.TSFile, generator.IncludeTS, "if set, the TypeScript declaration of the enum, with type guards, will be written. The value is the file name; default srcdir/<type>_string.ts")
+	flag.StringVar(&opts.TSStyle, generator.TSStyle, "", "style of the TypeScript declaration: \"union\" of string literals or \"as const\" \"object\". Default: union")
+	optionalValueVar(&opts.JSONSchema, &opts.JSONSchemaFile, generator.IncludeJSONSchema, "if set, the JSON Schema of the values UnmarshalJSON accepts will be written. The value is the file name; default srcdir/<type>_string.schema.json")
+	optionalValueVar(&opts.OpenAPI, &opts.OpenAPIFile, generator.IncludeOpenAPI, "if set, an OpenAPI components document with the schema of the values UnmarshalJSON accepts will be written. The value is the file name; default srcdir/<type>_string.openapi.yaml")
+	optionalValueVar(&opts.Proto, &opts.ProtoFile, generator.IncludeProto, "if set, the proto enum declaration of the enum will be written, and functions converting to and from its numbers generated. The value is the file name; default srcdir/<type>_string.proto")
+	flag.StringVar(&opts.ProtoPackage, generator.ProtoPackage, "", "package of the proto enum declaration. Default: the name of the Go package")
+	flag.StringVar(&opts.Templates, generator.TemplatesDir, "", "directory of <name>.tmpl files replacing the templates of the generated methods with the same names. Default: none")
+	flag.Var((*arrayFlags)(&opts.EmitTemplates), generator.EmitTemplate, "template rendered with the model of the enum types, as path.tmpl:out.ext, can repeat. Default: none")
+	flag.Var(&comments, "comment", "comments to include in generated code, can repeat. Default: \"\"")
+
+	plugins := make(map[string]*bool)
+	for _, p := range generator.Plugins() {
+		plugins[p.Name] = flag.Bool(p.Name, false, p.Usage)
+	}
+	return plugins
+}
+
+type arrayFlags []string
+
+func (af arrayFlags) String() string {
+	return strings.Join(af, "")
+}
+
+func (af *arrayFlags) Set(value string) error {
+	*af = append(*af, value)
+	return nil
+}
+
+// optionalValue is a flag that can be given alone, like a boolean flag
+// ("-binary"), or with a value ("-binary=name"). Either form sets the
+// boolean; only the second one records a value.
+type optionalValue struct {
+	set   *bool
+	value *string
+}
+
+// optionalValueVar defines an optionalValue flag with the specified name and
+// usage string, storing the boolean in set and the value in value.
+func optionalValueVar(set *bool, value *string, name, usage string) {
+	flag.Var(optionalValue{set: set, value: value}, name, usage)
+}
+
+func (f optionalValue) IsBoolFlag() bool {
+	return true
+}
+
+func (f optionalValue) String() string {
+	if f.set == nil || !*f.set {
+		return ""
+	}
+	if *f.value == "" {
+		return "true"
+	}
+	return *f.value
+}
+
+func (f optionalValue) Set(value string) error {
+	switch value {
+	case "true":
+		*f.set, *f.value = true, ""
+	case "false":
+		*f.set, *f.value = false, ""
+	default:
+		*f.set, *f.value = true, value
+	}
+	return nil
+}
+
+var (
+	typeNames = flag.String("type", "", "comma-separated list of type names; must be set")
+	output    = flag.String("output", "", "output file name; default srcdir/<type>_string.go")
+)
+
+var comments arrayFlags
+
+// Usage is a replacement usage function for the flags package.
+func Usage() {
+	fmt.Fprintf(os.Stderr, "Usage of %s:\n", os.Args[0])
+	fmt.Fprintf(os.Stderr, "\tenumer [flags] -type T [directory]\n")
+	fmt.Fprintf(os.Stderr, "\tenumer [flags] -type T files... # Must be a single package\n")
+	fmt.Fprintf(os.Stderr, "For more information, see:\n")
+	fmt.Fprintf(os.Stderr, "\thttps://github.com/SpectraLogic/enumer\n")
+	fmt.Fprintf(os.Stderr, "Flags:\n")
+	flag.PrintDefaults()
+	fmt.Fprintf(os.Stderr, generator.TransformationsText)
+}
+
+// Main runs enumer with the command line arguments. It exits the process on
+// errors.
+func Main() {
+	log.SetFlags(0)
+	log.SetPrefix("enumer: ")
+	plugins := defineFlags()
+	flag.Usage = Usage
+	flag.Parse()
+	for _, p := range generator.Plugins() {
+		if *plugins[p.Name] {
+			opts.Plugins = append(opts.Plugins, p.Name)
+		}
+	}
+	if len(*typeNames) == 0 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	if err := opts.Validate(); err != nil {
+		fmt.Fprintf(os.Stderr, "%s\n", err)
+		os.Exit(2)
+	}
+
+	files, err := generator.Generate(context.Background(), generator.Config{
+		Types:    strings.Split(*typeNames, ","),
+		Patterns: flag.Args(),
+		Output:   *output,
+		Comments: comments,
+		Args:     os.Args[1:],
+		Options:  opts,
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	// Write the Go file to a tmpfile first
+	goFile := files[0]
+	tmpName := fmt.Sprintf("%s_enumer_", filepath.Base(goFile.Name))
+	tmpFile, err := ioutil.TempFile(filepath.Dir(goFile.Name), tmpName)
+	if err != nil {
+		log.Fatalf("creating temporary file for output: %s", err)
+	}
+	_, err = tmpFile.Write(goFile.Data)
+	if err != nil {
+		tmpFile.Close()
+		os.Remove(tmpFile.Name())
+		log.Fatalf("writing output: %s", err)
+	}
+	tmpFile.Close()
+
+	// Rename tmpfile to output file
+	err = os.Rename(tmpFile.Name(), goFile.Name)
+	if err != nil {
+		log.Fatalf("moving tempfile to output file: %s", err)
+	}
+
+	// Write the side files.
+	for _, f := range files[1:] {
+		err = ioutil.WriteFile(f.Name, f.Data, 0644)
+		if err != nil {
+			log.Fatalf("writing %s: %s", f.Name, err)
+		}
+	}
+}
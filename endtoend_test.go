@@ -60,33 +60,81 @@ func TestEndToEnd(t *testing.T) {
 		// Names are known to be ASCII and long enough.
 		typeName := fmt.Sprintf("%c%s", name[0]+'A'-'a', name[1:len(name)-len(".go")])
 		transformNameMethod := "noop"
+		var flags []string
 
 		if name == "transform.go" {
 			typeName = "CamelCaseValue"
 			transformNameMethod = "snake"
 		}
+		if name == "priority.go" {
+			flags = []string{"-enum"}
+		}
+		if name == "pgarray.go" {
+			flags = []string{"-linecomment", "-sql", "-pgarray", "-null"}
+		}
+		if name == "nullable.go" {
+			flags = []string{"-null", "-sql", "-json", "-text", "-xml", "-binary"}
+		}
+		if name == "small.go" {
+			typeName = "Small,Usmall"
+			flags = []string{"-binary"}
+		}
+		if name == "yamlv3.go" {
+			typeName = "Small"
+			flags = []string{"-yaml=v3", "-numeric", "-null"}
+			moduleCompileAndRun(t, dir, stringer, typeName, name, transformNameMethod, flags...)
+			continue
+		}
+
+		stringerCompileAndRun(t, dir, stringer, typeName, name, transformNameMethod, flags...)
+	}
+}
 
-		stringerCompileAndRun(t, dir, stringer, typeName, name, transformNameMethod)
+// moduleCompileAndRun is stringerCompileAndRun for the programs that import
+// packages from outside the standard library, such as gopkg.in/yaml.v3: the
+// program gets a module of its own, in a subdirectory of dir, whose
+// requirements go mod tidy resolves. The program is skipped if they can't be.
+func moduleCompileAndRun(t *testing.T, dir, stringer, typeName, fileName, transformNameMethod string, flags ...string) {
+	sub := filepath.Join(dir, strings.TrimSuffix(fileName, ".go"))
+	if err := os.Mkdir(sub, 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(sub, "go.mod"), []byte("module e2e\n\ngo 1.25\n"), 0644); err != nil {
+		t.Fatal(err)
 	}
+	if err := copy(filepath.Join(sub, fileName), filepath.Join("testdata", fileName)); err != nil {
+		t.Fatalf("copying file to temporary directory: %s", err)
+	}
+	if err := runInDir(sub, "go", "mod", "tidy"); err != nil {
+		t.Logf("skipping %s: resolving its requirements: %s", fileName, err)
+		return
+	}
+	stringerCompileAndRun(t, sub, stringer, typeName, fileName, transformNameMethod, flags...)
 }
 
 // stringerCompileAndRun runs stringer for the named file and compiles and
 // runs the target binary in directory dir. That binary will panic if the String method is incorrect.
-func stringerCompileAndRun(t *testing.T, dir, stringer, typeName, fileName, transformNameMethod string) {
+// The flags are passed to stringer as well.
+func stringerCompileAndRun(t *testing.T, dir, stringer, typeName, fileName, transformNameMethod string, flags ...string) {
 	t.Logf("run: %s %s\n", fileName, typeName)
 	source := filepath.Join(dir, fileName)
 	err := copy(source, filepath.Join("testdata", fileName))
 	if err != nil {
 		t.Fatalf("copying file to temporary directory: %s", err)
 	}
-	stringSource := filepath.Join(dir, typeName+"_string.go")
-	// Run stringer in temporary directory.
-	err = run(stringer, "-type", typeName, "-output", stringSource, "-transform", transformNameMethod, source)
+	stringSource := filepath.Join(dir, strings.TrimSuffix(fileName, ".go")+"_string.go")
+	// Run stringer and the binary in the temporary directory, in its own
+	// module if it has one, or else in the module of stringer.
+	runDir := "."
+	if _, err := os.Stat(filepath.Join(dir, "go.mod")); err == nil {
+		runDir = dir
+	}
+	args := append([]string{"-type", typeName, "-output", stringSource, "-transform", transformNameMethod}, flags...)
+	err = runInDir(runDir, stringer, append(args, source)...)
 	if err != nil {
 		t.Fatal(err)
 	}
-	// Run the binary in the temporary directory.
-	err = run("go", "run", stringSource, source)
+	err = runInDir(runDir, "go", "run", stringSource, source)
 	if err != nil {
 		t.Fatal(err)
 	}
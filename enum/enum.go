@@ -0,0 +1,58 @@
+// Package enum holds the interfaces that the types generated by enumer -enum
+// implement, so generic code can work over any enum:
+//
+//	color, err := enum.Parse[Color]("red")
+//	for _, c := range enum.Values[Color]() {
+//		...
+//	}
+package enum
+
+import "fmt"
+
+// Enum is implemented by every enum type generated with enumer -enum.
+type Enum interface {
+	comparable
+	fmt.Stringer
+
+	// IsValid reports whether the value is listed in the enum definition.
+	IsValid() bool
+}
+
+// Descriptor gives access to all values of the enum type E.
+type Descriptor[E any] interface {
+	// Parse returns the value whose string representation is s.
+	Parse(s string) (E, error)
+
+	// Values returns all values of the enum, sorted by value.
+	Values() []E
+}
+
+// Described is implemented by the enum types whose descriptor can be
+// retrieved from any value, including the zero value.
+type Described[E any] interface {
+	Enum
+
+	// Descriptor returns the descriptor of the enum type.
+	Descriptor() Descriptor[E]
+}
+
+// ValueInfo describes a value of the enum type E, as generated by enumer -info.
+type ValueInfo[E any] struct {
+	GoName  string // Name of the constant in the Go source
+	Name    string // String representation of the value
+	Value   E      // The value itself
+	Doc     string // Doc comment of the constant, if any
+	Comment string // Line comment of the constant, if any
+}
+
+// Parse returns the value of the enum type E whose string representation is s.
+func Parse[E Described[E]](s string) (E, error) {
+	var zero E
+	return zero.Descriptor().Parse(s)
+}
+
+// Values returns all values of the enum type E, sorted by value.
+func Values[E Described[E]]() []E {
+	var zero E
+	return zero.Descriptor().Values()
+}
@@ -0,0 +1,60 @@
+package enum
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+// Color is a minimal implementation of Described, for the unit tests of the
+// package; the code enumer -enum generates is tested end to end with
+// testdata/priority.go.
+type Color int
+
+const (
+	Red Color = iota
+	Green
+	Blue
+)
+
+var colorNames = []string{"Red", "Green", "Blue"}
+
+func (c Color) String() string {
+	if !c.IsValid() {
+		return fmt.Sprintf("Color(%d)", int(c))
+	}
+	return colorNames[c]
+}
+
+func (c Color) IsValid() bool { return c >= Red && c <= Blue }
+
+func (Color) Descriptor() Descriptor[Color] { return colorDescriptor{} }
+
+type colorDescriptor struct{}
+
+func (colorDescriptor) Parse(s string) (Color, error) {
+	for i, name := range colorNames {
+		if name == s {
+			return Color(i), nil
+		}
+	}
+	return 0, fmt.Errorf("%s does not belong to Color values", s)
+}
+
+func (colorDescriptor) Values() []Color { return []Color{Red, Green, Blue} }
+
+func TestParse(t *testing.T) {
+	c, err := Parse[Color]("Green")
+	if err != nil || c != Green {
+		t.Errorf("Parse(Green) = %v, %v; want Green, nil", c, err)
+	}
+	if _, err := Parse[Color]("Purple"); err == nil {
+		t.Error("Parse(Purple) didn't fail")
+	}
+}
+
+func TestValues(t *testing.T) {
+	if got, want := Values[Color](), []Color{Red, Green, Blue}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Values() = %v; want %v", got, want)
+	}
+}
@@ -0,0 +1,109 @@
+package enum
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+)
+
+// Value describes one value of a registered enum type.
+type Value struct {
+	Name    string // String representation of the value
+	Value   any    // The value itself, of the enum type
+	Comment string // Line comment of the constant, if any
+}
+
+// Type describes an enum type registered by the code that enumer -register
+// generates.
+type Type struct {
+	Name      string  // Name of the Go type, such as "Color"
+	Package   string  // Import path of the package that declares the type
+	Transform string  // Transform method that produced the string representations
+	Values    []Value // Values of the enum, sorted by value
+
+	// Parse returns the value, of the enum type, whose string representation
+	// is s.
+	Parse func(s string) (any, error)
+}
+
+// QualifiedName returns the name of the type qualified by its package path,
+// such as "example.com/paint.Color".
+func (t *Type) QualifiedName() string {
+	return t.Package + "." + t.Name
+}
+
+var (
+	registryMu sync.RWMutex
+	registry   = make(map[string]*Type)
+)
+
+// Register makes the enum type available through Lookup, Types and ParseAny.
+// It is called from the init functions of the generated code; it panics if a
+// type with the same qualified name is registered twice, which enumer rules
+// out by refusing -register when the import path of the package is unknown.
+func Register(t Type) {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+	name := t.QualifiedName()
+	if _, dup := registry[name]; dup {
+		panic("enum: Register called twice for type " + name)
+	}
+	registry[name] = t.clone()
+}
+
+// clone returns a copy of the type that shares no values with it, so the
+// callers of Lookup and Types can't modify the registry.
+func (t *Type) clone() *Type {
+	c := *t
+	c.Values = append([]Value(nil), t.Values...)
+	return &c
+}
+
+// Lookup returns the registered enum type with the given name. The name is
+// either qualified by the package path, such as "example.com/paint.Color",
+// or the bare type name, such as "Color", when a single package registers a
+// type with that name. The type returned is a copy of the registered one.
+func Lookup(name string) (*Type, bool) {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+	if t, ok := registry[name]; ok {
+		return t.clone(), true
+	}
+	var found *Type
+	for _, t := range registry {
+		if t.Name == name {
+			if found != nil {
+				return nil, false
+			}
+			found = t
+		}
+	}
+	if found == nil {
+		return nil, false
+	}
+	return found.clone(), true
+}
+
+// Types returns copies of all registered enum types, sorted by qualified name.
+func Types() []*Type {
+	registryMu.RLock()
+	types := make([]*Type, 0, len(registry))
+	for _, t := range registry {
+		types = append(types, t.clone())
+	}
+	registryMu.RUnlock()
+	sort.Slice(types, func(i, j int) bool {
+		return types[i].QualifiedName() < types[j].QualifiedName()
+	})
+	return types
+}
+
+// ParseAny returns the value of the registered enum type typeName whose
+// string representation is s. The type name is resolved as by Lookup.
+func ParseAny(typeName, s string) (any, error) {
+	t, ok := Lookup(typeName)
+	if !ok {
+		return nil, fmt.Errorf("enum: unknown type %s", typeName)
+	}
+	return t.Parse(s)
+}
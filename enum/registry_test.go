@@ -0,0 +1,75 @@
+package enum
+
+import (
+	"sync"
+	"testing"
+)
+
+func registerColor() {
+	values := make([]Value, len(colorNames))
+	for i, name := range colorNames {
+		values[i] = Value{Name: name, Value: Color(i)}
+	}
+	Register(Type{
+		Name:      "Color",
+		Package:   "example.com/paint",
+		Transform: "noop",
+		Values:    values,
+		Parse: func(s string) (any, error) {
+			v, err := colorDescriptor{}.Parse(s)
+			if err != nil {
+				return nil, err
+			}
+			return v, nil
+		},
+	})
+}
+
+var registerOnce sync.Once
+
+func TestRegistry(t *testing.T) {
+	registerOnce.Do(registerColor)
+
+	for _, name := range []string{"Color", "example.com/paint.Color"} {
+		typ, ok := Lookup(name)
+		if !ok {
+			t.Fatalf("Lookup(%q) failed", name)
+		}
+		if got := len(typ.Values); got != 3 {
+			t.Errorf("Lookup(%q) has %d values; want 3", name, got)
+		}
+	}
+	if _, ok := Lookup("Shape"); ok {
+		t.Error("Lookup(Shape) succeeded")
+	}
+
+	v, err := ParseAny("Color", "Blue")
+	if err != nil || v != Blue {
+		t.Errorf("ParseAny(Color, Blue) = %v, %v; want Blue, nil", v, err)
+	}
+	if _, err := ParseAny("Shape", "Square"); err == nil {
+		t.Error("ParseAny(Shape, Square) didn't fail")
+	}
+	if _, err := ParseAny("Color", "Purple"); err == nil {
+		t.Error("ParseAny(Color, Purple) didn't fail")
+	}
+
+	typ, _ := Lookup("Color")
+	typ.Name = "Shape"
+	typ.Values[0].Name = "Purple"
+	if typ, _ := Lookup("Color"); typ.Name != "Color" || typ.Values[0].Name != "Red" {
+		t.Errorf("modifying the result of Lookup changed the registry: %+v", typ)
+	}
+
+	types := Types()
+	if len(types) != 1 || types[0].QualifiedName() != "example.com/paint.Color" {
+		t.Errorf("Types() = %v; want example.com/paint.Color", types)
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("registering Color twice didn't panic")
+		}
+	}()
+	registerColor()
+}
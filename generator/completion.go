@@ -0,0 +1,129 @@
+package generator
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/pascaldekloe/name"
+)
+
+const (
+	ShellBash = "bash"
+	ShellZsh  = "zsh"
+	ShellFish = "fish"
+)
+
+// completion is a shell completion candidate: a value and its description.
+type completion struct {
+	value       string
+	description string
+}
+
+// completions returns the completion candidates for the values in the runs.
+// Line comments become the descriptions, unless they are the values already.
+func completions(runs [][]Value) []completion {
+	var list []completion
+	for _, values := range runs {
+		for _, value := range values {
+			c := completion{value: value.name}
+			if value.comment != value.name {
+				c.description = value.comment
+			}
+			list = append(list, c)
+		}
+	}
+	return list
+}
+
+const completionsFunc = `
+// {{.TypeName}}Completions returns the values of the enum as shell completions, in the
+// "value\tdescription" format of the cobra ValidArgsFunction
+func {{.TypeName}}Completions() []string {
+	return []string{
+{{- range .Values}}
+		{{if and .Comment (ne .Comment .Name)}}{{printf "%q" (print .Name "\t" .Comment)}}{{else}}{{printf "%q" .Name}}{{end}},
+{{- end}}
+	}
+}
+`
+
+func init() {
+	registerBuiltin(Plugin{
+		Name: IncludeCompletion,
+		Generate: func(w io.Writer, e *Enum) error {
+			return e.g.executeTo(w, "completionsFunc")
+		},
+	})
+}
+
+// buildCompletionScripts writes, for each of the shells, a function that
+// completes the values of the enum to the shell's side file.
+func (g *Generator) buildCompletionScripts(runs [][]Value, typeName string, shells []string) {
+	funcName := strings.ToLower(name.Delimit(typeName, '_')) + "_values"
+	list := completions(runs)
+	for _, shell := range shells {
+		b := g.sideFile("", "."+shell, "#")
+		if b.Len() > 0 {
+			b.WriteString("\n")
+		}
+		switch shell {
+		case ShellBash:
+			fmt.Fprintf(b, "# Completes the values of %s.\n", typeName)
+			fmt.Fprintf(b, "_%s() {\n", funcName)
+			fmt.Fprintf(b, "    local cur=\"${COMP_WORDS[COMP_CWORD]}\" v\n")
+			fmt.Fprintf(b, "    local values=(")
+			for i, c := range list {
+				if i > 0 {
+					b.WriteString(" ")
+				}
+				b.WriteString(shellQuote(c.value))
+			}
+			fmt.Fprintf(b, ")\n")
+			fmt.Fprintf(b, "    COMPREPLY=()\n")
+			fmt.Fprintf(b, "    for v in \"${values[@]}\"; do\n")
+			fmt.Fprintf(b, "        [[ $v == \"$cur\"* ]] && COMPREPLY+=(\"$v\")\n")
+			fmt.Fprintf(b, "    done\n")
+			fmt.Fprintf(b, "}\n")
+		case ShellZsh:
+			fmt.Fprintf(b, "# Completes the values of %s.\n", typeName)
+			fmt.Fprintf(b, "_%s() {\n", funcName)
+			fmt.Fprintf(b, "  local -a values\n")
+			fmt.Fprintf(b, "  values=(\n")
+			for _, c := range list {
+				// _describe splits the value from its description at the first
+				// unescaped colon.
+				entry := strings.Replace(c.value, ":", "\\:", -1)
+				if c.description != "" {
+					entry += ":" + c.description
+				}
+				fmt.Fprintf(b, "    %s\n", shellQuote(entry))
+			}
+			fmt.Fprintf(b, "  )\n")
+			fmt.Fprintf(b, "  _describe %s values\n", shellQuote(typeName))
+			fmt.Fprintf(b, "}\n")
+		case ShellFish:
+			fmt.Fprintf(b, "# Prints the values of %s, for complete -a '(__%s)'.\n", typeName, funcName)
+			fmt.Fprintf(b, "function __%s\n", funcName)
+			for _, c := range list {
+				if c.description == "" {
+					fmt.Fprintf(b, "    printf '%%s\\n' %s\n", fishQuote(c.value))
+				} else {
+					fmt.Fprintf(b, "    printf '%%s\\t%%s\\n' %s %s\n", fishQuote(c.value), fishQuote(c.description))
+				}
+			}
+			fmt.Fprintf(b, "end\n")
+		}
+	}
+}
+
+// shellQuote returns s as a single-quoted bash or zsh word.
+func shellQuote(s string) string {
+	return "'" + strings.Replace(s, "'", `'\''`, -1) + "'"
+}
+
+// fishQuote returns s as a single-quoted fish word.
+func fishQuote(s string) string {
+	s = strings.Replace(s, `\`, `\\`, -1)
+	return "'" + strings.Replace(s, "'", `\'`, -1) + "'"
+}
@@ -0,0 +1,164 @@
+package generator
+
+import (
+	"fmt"
+	"io/ioutil"
+	"regexp"
+	"strings"
+
+	"github.com/pascaldekloe/name"
+)
+
+const (
+	DDLPostgres = "postgres"
+	DDLMySQL    = "mysql"
+	DDLSQLite   = "sqlite"
+)
+
+// buildDDL writes the SQL declaration of the enum, in the given dialect, to
+// the DDL side file. The values are the string representations that the
+// Valuer stores. If prevName names a previously generated PostgreSQL DDL
+// file, the ALTER TYPE statements that add the new values are written to a
+// second side file, named after the first one with a .alter.sql extension.
+func (g *Generator) buildDDL(runs [][]Value, typeName, dialect, fileName, prevName string) {
+	var names []string
+	for _, values := range runs {
+		for _, value := range values {
+			names = append(names, value.name)
+		}
+	}
+	sqlName := strings.ToLower(name.Delimit(typeName, '_'))
+
+	b := g.sideFile(fileName, ".sql", "--")
+	if b.Len() > 0 {
+		b.WriteString("\n")
+	}
+	switch dialect {
+	case DDLPostgres:
+		fmt.Fprintf(b, "CREATE TYPE %s AS ENUM (%s);\n", sqlName, sqlList(names))
+	case DDLMySQL:
+		fmt.Fprintf(b, "-- Column type for %s\n", typeName)
+		fmt.Fprintf(b, "ENUM(%s)\n", sqlList(names))
+	case DDLSQLite:
+		fmt.Fprintf(b, "-- Column constraint for %s\n", typeName)
+		fmt.Fprintf(b, "CHECK (%s IN (%s))\n", sqlName, sqlList(names))
+	}
+
+	if prevName == "" {
+		return
+	}
+	prev, err := ioutil.ReadFile(prevName)
+	if err != nil {
+		failf("reading previous DDL: %s", err)
+	}
+	prevNames, ok := parsePostgresEnum(prev, sqlName)
+	if !ok {
+		failf("%s: no CREATE TYPE %s AS ENUM statement", prevName, sqlName)
+	}
+	stmts := postgresAlter(sqlName, names, prevNames)
+	if len(stmts) == 0 {
+		return
+	}
+	alterName := strings.TrimSuffix(g.sideFileName(fileName, ".sql"), ".sql") + ".alter.sql"
+	b = g.sideFile(alterName, "", "--")
+	if b.Len() > 0 {
+		b.WriteString("\n")
+	}
+	for _, stmt := range stmts {
+		b.WriteString(stmt)
+	}
+}
+
+// postgresAlter returns the statements that turn the enum declared with the
+// previous names into the one declared with the current names. Each new value
+// is placed next to a value that is declared before it, so the order of the
+// values is kept.
+func postgresAlter(sqlName string, names, prevNames []string) []string {
+	declared := make(map[string]bool)
+	for _, n := range prevNames {
+		declared[n] = true
+	}
+	var stmts []string
+	for i, n := range names {
+		if declared[n] {
+			continue
+		}
+		position := ""
+		if i > 0 {
+			position = " AFTER " + sqlQuote(names[i-1])
+		} else {
+			for _, next := range names[1:] {
+				if declared[next] {
+					position = " BEFORE " + sqlQuote(next)
+					break
+				}
+			}
+		}
+		stmts = append(stmts, fmt.Sprintf("ALTER TYPE %s ADD VALUE %s%s;\n", sqlName, sqlQuote(n), position))
+		declared[n] = true
+	}
+	current := make(map[string]bool)
+	for _, n := range names {
+		current[n] = true
+	}
+	for _, n := range prevNames {
+		if !current[n] {
+			// PostgreSQL can't drop a value from an enum type.
+			stmts = append(stmts, fmt.Sprintf("-- %s was removed, but it can't be dropped from %s\n", sqlQuote(n), sqlName))
+		}
+	}
+	return stmts
+}
+
+var createEnumRE = regexp.MustCompile(`(?i)CREATE\s+TYPE\s+"?([\w.]+)"?\s+AS\s+ENUM\s*\(`)
+
+// parsePostgresEnum returns the values of the enum type sqlName declared by
+// a CREATE TYPE statement in ddl.
+func parsePostgresEnum(ddl []byte, sqlName string) ([]string, bool) {
+	for _, m := range createEnumRE.FindAllSubmatchIndex(ddl, -1) {
+		if !strings.EqualFold(string(ddl[m[2]:m[3]]), sqlName) {
+			continue
+		}
+		var names []string
+		s := string(ddl[m[1]:])
+		for {
+			s = strings.TrimLeft(s, " \t\r\n,")
+			if s == "" || s[0] != '\'' {
+				return names, strings.HasPrefix(s, ")")
+			}
+			// Read a quoted literal; a doubled quote stands for a quote.
+			var lit strings.Builder
+			i := 1
+			for ; i < len(s); i++ {
+				if s[i] == '\'' {
+					if i+1 < len(s) && s[i+1] == '\'' {
+						i++
+					} else {
+						break
+					}
+				}
+				lit.WriteByte(s[i])
+			}
+			if i == len(s) {
+				return nil, false
+			}
+			names = append(names, lit.String())
+			s = s[i+1:]
+		}
+	}
+	return nil, false
+}
+
+// sqlList returns the names as a comma-separated list of SQL string literals.
+func sqlList(names []string) string {
+	quoted := make([]string, len(names))
+	for i, n := range names {
+		quoted[i] = sqlQuote(n)
+	}
+	return strings.Join(quoted, ", ")
+}
+
+// sqlQuote returns s as an SQL string literal.
+func sqlQuote(s string) string {
+	return "'" + strings.Replace(s, "'", "''", -1) + "'"
+}
@@ -0,0 +1,82 @@
+package generator
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+	"text/template"
+)
+
+// EmitData is the data the templates of Options.EmitTemplates are executed
+// with: every enum type generated in the run, so a template can write a
+// structured document, such as a JSON object, declaring all of them.
+type EmitData struct {
+	Package string  // Name of the package that declares the types
+	Enums   []*Enum // Enum types, in the order they are generated
+}
+
+// emitTemplate is a user template rendered to a side file, see
+// Options.EmitTemplates.
+type emitTemplate struct {
+	tmpl   *template.Template
+	output string // Name of the side file the template is rendered to.
+}
+
+// splitEmitTemplate splits a "path.tmpl:out.ext" specification into the path
+// of the template and the name of the file it is rendered to.
+func splitEmitTemplate(spec string) (path, output string, err error) {
+	i := strings.LastIndex(spec, ":")
+	if i <= 0 || i == len(spec)-1 {
+		return "", "", fmt.Errorf("invalid -%s %q: expected path.tmpl:out.ext", EmitTemplate, spec)
+	}
+	return spec[:i], spec[i+1:], nil
+}
+
+// validateEmitTemplates reports the first malformed specification.
+func validateEmitTemplates(specs []string) error {
+	for _, spec := range specs {
+		if _, _, err := splitEmitTemplate(spec); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// loadEmitTemplates parses the templates of the specifications.
+func loadEmitTemplates(specs []string) ([]emitTemplate, error) {
+	var emits []emitTemplate
+	for _, spec := range specs {
+		path, output, err := splitEmitTemplate(spec)
+		if err != nil {
+			return nil, err
+		}
+		tmpl, err := template.New(filepath.Base(path)).ParseFiles(path)
+		if err != nil {
+			return nil, err
+		}
+		emits = append(emits, emitTemplate{tmpl: tmpl, output: output})
+	}
+	return emits, nil
+}
+
+// collectEmitEnum adds the model of the enum to the ones the user templates
+// are rendered with, if there are any.
+func (g *Generator) collectEmitEnum(runs [][]Value, typeName string) {
+	if len(g.emits) > 0 {
+		g.enums = append(g.enums, newEnum(g.pkg.name, typeName, runs))
+	}
+}
+
+// buildEmitTemplates renders the user templates, each to its side file, with
+// the models of all the types generated. It runs once, after the types, so
+// each template is executed once with complete data. The side files have no
+// generated code header, as their language is unknown.
+func (g *Generator) buildEmitTemplates() {
+	data := &EmitData{Package: g.pkg.name, Enums: g.enums}
+	for _, emit := range g.emits {
+		b := g.sideFile(emit.output, "", "")
+		if err := emit.tmpl.Execute(b, data); err != nil {
+			failf("-%s: %s", EmitTemplate, err)
+		}
+	}
+}
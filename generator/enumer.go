@@ -0,0 +1,812 @@
+package generator
+
+import (
+	"fmt"
+	"io"
+)
+
+const stringNameToValueMethod = `// {{.TypeName}}String retrieves an enum value from the enum constants string name.
+// Throws an error if the param is not part of the enum.
+func {{.TypeName}}String(s string) ({{.TypeName}}, error) {
+	if val, ok := _{{.TypeName}}NameToValueMap[s]; ok {
+		return val, nil
+	}{{if .Numeric}}{{template "stringNumericCheck" .}}{{end}}
+	return 0, fmt.Errorf("%s does not belong to {{.TypeName}} values", s)
+}
+`
+const stringIgnoreCaseNameToValueMethod = `// {{.TypeName}}String retrieves an enum value from the enum constants string name.
+// Throws an error if the param is not part of the enum.
+func {{.TypeName}}String(s string) ({{.TypeName}}, error) {
+	if val, ok := _{{.TypeName}}NameToValueMap[s]; ok {
+		return val, nil
+	}
+	for k, v := range _{{.TypeName}}NameToValueMap {
+		if strings.EqualFold(s, k) {
+			return v, nil
+		}
+	}{{if .Numeric}}{{template "stringNumericCheck" .}}{{end}}
+	return 0, fmt.Errorf("%s does not belong to {{.TypeName}} values", s)
+}
+`
+const stringUpperNameToValueMethod = `// {{.TypeName}}String retrieves an enum value from the enum constants string name.
+// Throws an error if the param is not part of the enum.
+func {{.TypeName}}String(s string) ({{.TypeName}}, error) {
+	if val, ok := _{{.TypeName}}NameToValueMap[strings.ToUpper(s)]; ok {
+		return val, nil
+	}{{if .Numeric}}{{template "stringNumericCheck" .}}{{end}}
+	return 0, fmt.Errorf("%s does not belong to {{.TypeName}} values", s)
+}
+`
+const stringLowerNameToValueMethod = `// {{.TypeName}}String retrieves an enum value from the enum constants string name.
+// Throws an error if the param is not part of the enum.
+func {{.TypeName}}String(s string) ({{.TypeName}}, error) {
+	if val, ok := _{{.TypeName}}NameToValueMap[strings.ToLower(s)]; ok {
+		return val, nil
+	}{{if .Numeric}}{{template "stringNumericCheck" .}}{{end}}
+	return 0, fmt.Errorf("%s does not belong to {{.TypeName}} values", s)
+}
+`
+
+const stringNumericCheck = `
+	i, err := strconv.Atoi(s)
+	if err == nil {
+		for _, v := range _{{.TypeName}}NameToValueMap {
+			if int(v) == i {
+				return v, nil
+			}
+		}
+	}`
+
+type CaseMatch int
+
+const (
+	CaseNone = iota
+	CaseLower
+	CaseUpper
+	CaseMixed
+)
+
+// caseMatch returns how <Type>String matches the names: as they are, or with
+// -ignorecase after converting the string to the case of the names, if the
+// transformation gives them a single case, or else in any case.
+func caseMatch(ignoreCase bool, transform string) CaseMatch {
+	switch {
+	case !ignoreCase:
+		return CaseNone
+	case transform == ToUpper || transform == ToSnakeUpper || transform == ToKebabUpper:
+		return CaseUpper
+	case transform == ToLower || transform == ToSnake || transform == ToKebab:
+		return CaseLower
+	}
+	return CaseMixed
+}
+
+const stringValuesMethod = `// {{.TypeName}}Values returns all values of the enum
+func {{.TypeName}}Values() []{{.TypeName}} {
+	return _{{.TypeName}}Values
+}
+`
+
+const stringValuesCopyMethod = `// {{.TypeName}}Values returns all values of the enum, in a new slice the caller may modify
+func {{.TypeName}}Values() []{{.TypeName}} {
+	values := make([]{{.TypeName}}, len(_{{.TypeName}}Values))
+	copy(values, _{{.TypeName}}Values)
+	return values
+}
+`
+
+const stringBelongsMethodLoop = `// IsA{{.TypeName}} returns "true" if the value is listed in the enum definition. "false" otherwise
+func (i {{.TypeName}}) IsA{{.TypeName}}() bool {
+	for _, v := range _{{.TypeName}}Values {
+		if i == v {
+			return true
+		}
+	}
+	return false
+}
+`
+
+const stringBelongsMethodSet = `// IsA{{.TypeName}} returns "true" if the value is listed in the enum definition. "false" otherwise
+func (i {{.TypeName}}) IsA{{.TypeName}}() bool {
+	_, ok := _{{.TypeName}}Map[i] 
+	return ok
+}
+`
+
+func (g *Generator) buildBasicExtras(runs [][]Value, typeName string, runsThreshold int, ignoreCase CaseMatch, valuesCopy bool) {
+	// At this moment, either "g.declareIndexAndNameVars()" or "g.declareNameVars()" has been called
+
+	// Print the slice of values
+	g.Printf("\nvar _%sValues = []%s{", typeName, typeName)
+	for _, values := range runs {
+		for _, value := range values {
+			g.Printf("\t%s, ", value.str)
+		}
+	}
+	g.Printf("}\n\n")
+
+	// Print the map between name and value
+	g.Printf("\nvar _%sNameToValueMap = map[string]%s{\n", typeName, typeName)
+	thereAreRuns := len(runs) > 1 && len(runs) <= runsThreshold
+	var n int
+	var runID string
+	for i, values := range runs {
+		if thereAreRuns {
+			runID = "_" + fmt.Sprintf("%d", i)
+			n = 0
+		} else {
+			runID = ""
+		}
+
+		for _, value := range values {
+			g.Printf("\t_%sName%s[%d:%d]: %s,\n", typeName, runID, n, n+len(value.name), &value)
+			n += len(value.name)
+		}
+	}
+	g.Printf("}\n\n")
+
+	// Print the basic extra methods
+	switch ignoreCase {
+	case CaseLower:
+		g.execute("stringLowerNameToValueMethod")
+	case CaseUpper:
+		g.execute("stringUpperNameToValueMethod")
+	case CaseMixed:
+		g.execute("stringIgnoreCaseNameToValueMethod")
+	default:
+		g.execute("stringNameToValueMethod")
+	}
+
+	if valuesCopy {
+		g.execute("stringValuesCopyMethod")
+	} else {
+		g.execute("stringValuesMethod")
+	}
+	if len(runs) <= runsThreshold {
+		g.execute("stringBelongsMethodLoop")
+	} else { // There is a map of values, the code is simpler then
+		g.execute("stringBelongsMethodSet")
+	}
+}
+
+const jsonMethods = `
+// MarshalJSON implements the json.Marshaler interface for {{.TypeName}}
+func (i {{.TypeName}}) MarshalJSON() ([]byte, error) {
+	return json.Marshal(i.String())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface for {{.TypeName}}
+func (i *{{.TypeName}}) UnmarshalJSON(data []byte) error {
+	var s string
+	var err error
+	if err = json.Unmarshal(data, &s); err != nil {
+{{if .Numeric}}{{template "jsonNumericCheck" .}}{{else}}{{template "jsonNoNumericCheck" .}}{{end}}	}
+
+	*i, err = {{.TypeName}}String(s)
+	return err
+}
+`
+
+const jsonNumericCheck = `		var val int
+		if err = json.Unmarshal(data, &val); err != nil {
+			return fmt.Errorf("{{.TypeName}} should be a string, got %s", data)
+		}
+		*i = {{.TypeName}}(val)
+		if !i.IsA{{.TypeName}}() {
+			return fmt.Errorf("Invalid value for {{.TypeName}} (%d)", val)
+		}
+		return nil
+`
+
+const jsonNoNumericCheck = `		return fmt.Errorf("{{.TypeName}} should be a string, got %s", data)
+`
+
+func init() {
+	registerBuiltin(Plugin{
+		Name:    IncludeJSON,
+		Imports: []string{"encoding/json"},
+		Generate: func(w io.Writer, e *Enum) error {
+			return e.g.executeTo(w, "jsonMethods")
+		},
+	})
+}
+
+const textMethods = `
+// MarshalText implements the encoding.TextMarshaler interface for {{.TypeName}}
+func (i {{.TypeName}}) MarshalText() ([]byte, error) {
+	return []byte(i.String()), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface for {{.TypeName}}
+func (i *{{.TypeName}}) UnmarshalText(text []byte) error {
+	var err error
+	*i, err = {{.TypeName}}String(string(text))
+	return err
+}
+`
+
+func init() {
+	registerBuiltin(Plugin{
+		Name: IncludeText,
+		Generate: func(w io.Writer, e *Enum) error {
+			return e.g.executeTo(w, "textMethods")
+		},
+	})
+}
+
+const yamlMethods = `
+// MarshalYAML implements a YAML Marshaler for {{.TypeName}}
+func (i {{.TypeName}}) MarshalYAML() (interface{}, error) {
+	return i.String(), nil
+}
+
+// UnmarshalYAML implements a YAML Unmarshaler for {{.TypeName}}
+func (i *{{.TypeName}}) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s string
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+
+	var err error
+	*i, err = {{.TypeName}}String(s)
+	return err
+}
+`
+
+const yamlV3Methods = `
+// MarshalYAML implements the yaml.Marshaler interface for {{.TypeName}}
+func (i {{.TypeName}}) MarshalYAML() (interface{}, error) {
+	return i.String(), nil
+}
+
+// UnmarshalYAML implements the yaml.Unmarshaler interface for {{.TypeName}}
+func (i *{{.TypeName}}) UnmarshalYAML(value *yaml.Node) error {
+	if value.Kind != yaml.ScalarNode {
+		return fmt.Errorf("%d:%d: {{.TypeName}} should be a scalar, got %s", value.Line, value.Column, value.ShortTag())
+	}{{if .Numeric}}{{template "yamlV3NumericCheck" .}}{{end}}
+
+	val, err := {{.TypeName}}String(value.Value)
+	if err != nil {
+		return fmt.Errorf("%d:%d: %w", value.Line, value.Column, err)
+	}
+	*i = val
+	return nil
+}
+`
+
+const yamlV3NumericCheck = `
+	if value.ShortTag() == "!!int" {
+		var n {{.NumberType}}
+		if err := value.Decode(&n); err != nil {
+			return fmt.Errorf("%d:%d: %w", value.Line, value.Column, err)
+		}
+		val := {{.TypeName}}(n)
+		if {{.NumberType}}(val) != n || !val.IsA{{.TypeName}}() {
+			return fmt.Errorf("%d:%d: Invalid value for {{.TypeName}} (%d)", value.Line, value.Column, n)
+		}
+		*i = val
+		return nil
+	}`
+
+func init() {
+	registerBuiltin(Plugin{
+		Name: IncludeYAML,
+		imports: func(options map[string]string) []string {
+			if options[YAMLVersion] == YAMLv3 {
+				return []string{"gopkg.in/yaml.v3"}
+			}
+			return nil
+		},
+		Generate: func(w io.Writer, e *Enum) error {
+			if e.options[YAMLVersion] != YAMLv3 {
+				return e.g.executeTo(w, "yamlMethods")
+			}
+			return e.g.executeTo(w, "yamlV3Methods")
+		},
+	})
+}
+
+const xmlMethods = `
+// MarshalXML implements the xml.Marshaler interface for {{.TypeName}}
+func (i {{.TypeName}}) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return e.EncodeElement(i.String(), start)
+}
+
+// UnmarshalXML implements the xml.Unmarshaler interface for {{.TypeName}}
+func (i *{{.TypeName}}) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	var s string
+	if err := d.DecodeElement(&s, &start); err != nil {
+		return err
+	}
+
+	var err error
+	*i, err = {{.TypeName}}String(s)
+	return err
+}
+
+// MarshalXMLAttr implements the xml.MarshalerAttr interface for {{.TypeName}}
+func (i {{.TypeName}}) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return xml.Attr{Name: name, Value: i.String()}, nil
+}
+
+// UnmarshalXMLAttr implements the xml.UnmarshalerAttr interface for {{.TypeName}}
+func (i *{{.TypeName}}) UnmarshalXMLAttr(attr xml.Attr) error {
+	var err error
+	*i, err = {{.TypeName}}String(attr.Value)
+	return err
+}
+`
+
+func init() {
+	registerBuiltin(Plugin{
+		Name:    IncludeXML,
+		Imports: []string{"encoding/xml"},
+		Generate: func(w io.Writer, e *Enum) error {
+			return e.g.executeTo(w, "xmlMethods")
+		},
+	})
+}
+
+const binaryVarintMethods = `
+// MarshalBinary implements the encoding.BinaryMarshaler interface for {{.TypeName}}
+func (i {{.TypeName}}) MarshalBinary() ([]byte, error) {
+	return binary.Append{{if .Signed}}Varint{{else}}Uvarint{{end}}(nil, {{.NumberType}}(i)), nil
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface for {{.TypeName}}
+func (i *{{.TypeName}}) UnmarshalBinary(data []byte) error {
+	v, n := binary.{{if .Signed}}Varint{{else}}Uvarint{{end}}(data)
+	if n <= 0 || n != len(data) {
+		return fmt.Errorf("{{.TypeName}} should be a varint, got %x", data)
+	}
+	val := {{.TypeName}}(v)
+	if {{.NumberType}}(val) != v || !val.IsA{{.TypeName}}() {
+		return fmt.Errorf("Invalid value for {{.TypeName}} (%d)", v)
+	}
+	*i = val
+	return nil
+}
+`
+
+const binaryNameMethods = `
+// MarshalBinary implements the encoding.BinaryMarshaler interface for {{.TypeName}}
+func (i {{.TypeName}}) MarshalBinary() ([]byte, error) {
+	return []byte(i.String()), nil
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface for {{.TypeName}}
+func (i *{{.TypeName}}) UnmarshalBinary(data []byte) error {
+	val, err := {{.TypeName}}String(string(data))
+	if err != nil {
+		return err
+	}
+	*i = val
+	return nil
+}
+`
+
+func init() {
+	registerBuiltin(Plugin{
+		Name: IncludeBinary,
+		imports: func(options map[string]string) []string {
+			if options[BinaryEncoding] != BinaryName {
+				return []string{"encoding/binary"}
+			}
+			return nil
+		},
+		Generate: func(w io.Writer, e *Enum) error {
+			if e.options[BinaryEncoding] == BinaryName {
+				return e.g.executeTo(w, "binaryNameMethods")
+			}
+			return e.g.executeTo(w, "binaryVarintMethods")
+		},
+	})
+}
+
+const flagMethods = `
+// Set implements the flag.Value interface for {{.TypeName}}
+func (i *{{.TypeName}}) Set(s string) error {
+	val, err := {{.TypeName}}String(s)
+	if err != nil {
+		return err
+	}
+	*i = val
+	return nil
+}
+
+// Type implements the pflag.Value interface for {{.TypeName}}
+func (i {{.TypeName}}) Type() string {
+	return "{{.TypeName}}"
+}
+
+// {{.TypeName}}Usage lists the values of the enum, for use in the usage string of a
+// {{.TypeName}} flag: flag.Var(&v, "name", "what it is; "+{{.TypeName}}Usage())
+func {{.TypeName}}Usage() string {
+	names := make([]string, len(_{{.TypeName}}Values))
+	for i, v := range _{{.TypeName}}Values {
+		names[i] = v.String()
+	}
+	return "one of " + strings.Join(names, ", ")
+}
+`
+
+func init() {
+	registerBuiltin(Plugin{
+		Name:    IncludeFlag,
+		Imports: []string{"strings"},
+		Generate: func(w io.Writer, e *Enum) error {
+			return e.g.executeTo(w, "flagMethods")
+		},
+	})
+}
+
+const listType = `
+// {{.TypeName}}List is a list of {{.TypeName}} values that is written as a single string,
+// with the values separated by {{printf "%q" .Separator}}. It is meant for flags and configuration.
+type {{.TypeName}}List []{{.TypeName}}
+
+// String implements the fmt.Stringer interface for {{.TypeName}}List
+func (l {{.TypeName}}List) String() string {
+	names := make([]string, len(l))
+	for i, v := range l {
+		names[i] = v.String()
+	}
+	return strings.Join(names, {{printf "%q" .Separator}})
+}
+
+// Set implements the flag.Value interface for {{.TypeName}}List. It replaces the list
+// with the values in s.
+func (l *{{.TypeName}}List) Set(s string) error {
+	var elems []string
+	if strings.TrimSpace(s) != "" {
+		elems = strings.Split(s, {{printf "%q" .Separator}})
+	}
+	list, err := _{{.TypeName}}ListParse(elems)
+	if err != nil {
+		return err
+	}
+	*l = list
+	return nil
+}
+
+// Type implements the pflag.Value interface for {{.TypeName}}List
+func (l {{.TypeName}}List) Type() string {
+	return "{{.TypeName}}List"
+}
+
+// MarshalText implements the encoding.TextMarshaler interface for {{.TypeName}}List
+func (l {{.TypeName}}List) MarshalText() ([]byte, error) {
+	return []byte(l.String()), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface for {{.TypeName}}List
+func (l *{{.TypeName}}List) UnmarshalText(text []byte) error {
+	return l.Set(string(text))
+}
+
+// MarshalJSON implements the json.Marshaler interface for {{.TypeName}}List. The list
+// is written as an array of strings.
+func (l {{.TypeName}}List) MarshalJSON() ([]byte, error) {
+	names := make([]string, len(l))
+	for i, v := range l {
+		names[i] = v.String()
+	}
+	return json.Marshal(names)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface for {{.TypeName}}List. The
+// list can be either an array of strings or a single separated string.
+func (l *{{.TypeName}}List) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err == nil {
+		return l.Set(s)
+	}
+	var elems []string
+	if err := json.Unmarshal(data, &elems); err != nil {
+		return fmt.Errorf("{{.TypeName}}List should be a string or an array of strings, got %s", data)
+	}
+	list, err := _{{.TypeName}}ListParse(elems)
+	if err != nil {
+		return err
+	}
+	*l = list
+	return nil
+}
+
+func _{{.TypeName}}ListParse(elems []string) ({{.TypeName}}List, error) {
+	var list {{.TypeName}}List
+	for n, elem := range elems {
+		val, err := {{.TypeName}}String(strings.TrimSpace(elem))
+		if err != nil {
+			return nil, fmt.Errorf("{{.TypeName}}List: element %d: %w", n, err)
+		}{{if .Dedupe}}{{template "listDedupeCheck" .}}{{end}}
+		list = append(list, val)
+	}
+	return list, nil
+}
+`
+
+const listDedupeCheck = `
+		duplicate := false
+		for _, v := range list {
+			if v == val {
+				duplicate = true
+				break
+			}
+		}
+		if duplicate {
+			continue
+		}`
+
+func init() {
+	registerBuiltin(Plugin{
+		Name:    IncludeList,
+		Imports: []string{"encoding/json", "strings"},
+		Generate: func(w io.Writer, e *Enum) error {
+			return e.g.executeTo(w, "listType")
+		},
+	})
+}
+
+const slogMethod = `
+// LogValue implements the slog.LogValuer interface for {{.TypeName}}. Values that don't
+// belong to the enum are logged as a group with invalid=true.
+func (i {{.TypeName}}) LogValue() slog.Value {
+	if !i.IsA{{.TypeName}}() {
+		return slog.GroupValue(slog.{{if .Signed}}Int64{{else}}Uint64{{end}}("value", {{.NumberType}}(i)), slog.Bool("invalid", true))
+	}
+	return slog.StringValue(i.String())
+}
+`
+
+const slogGroupMethod = `
+// The groups are built once, so that logging a value doesn't allocate.
+var _{{.TypeName}}LogValues = func() map[{{.TypeName}}]slog.Value {
+	m := make(map[{{.TypeName}}]slog.Value, len(_{{.TypeName}}Values))
+	for _, v := range _{{.TypeName}}Values {
+		m[v] = slog.GroupValue(slog.String("name", v.String()), slog.{{if .Signed}}Int64{{else}}Uint64{{end}}("value", {{.NumberType}}(v)))
+	}
+	return m
+}()
+
+// LogValue implements the slog.LogValuer interface for {{.TypeName}}. Values are logged
+// as a group with their name and number; values that don't belong to the enum
+// have no name and invalid=true instead.
+func (i {{.TypeName}}) LogValue() slog.Value {
+	if v, ok := _{{.TypeName}}LogValues[i]; ok {
+		return v
+	}
+	return slog.GroupValue(slog.{{if .Signed}}Int64{{else}}Uint64{{end}}("value", {{.NumberType}}(i)), slog.Bool("invalid", true))
+}
+`
+
+func init() {
+	registerBuiltin(Plugin{
+		Name:    IncludeSlog,
+		Imports: []string{"log/slog"},
+		Generate: func(w io.Writer, e *Enum) error {
+			if e.flags[SlogGroup] {
+				return e.g.executeTo(w, "slogGroupMethod")
+			}
+			return e.g.executeTo(w, "slogMethod")
+		},
+	})
+}
+
+const formatMethods = `
+var _{{.TypeName}}GoNames = map[{{.TypeName}}]string{
+{{- range .Values}}
+	{{.Literal}}: {{printf "%q" .GoName}},
+{{- end}}
+}
+
+// Format implements the fmt.Formatter interface for {{.TypeName}}: %v and %s print the
+// name, %q the quoted name, %+v the name followed by the number in parentheses,
+// %#v the Go identifier and every other verb, such as %d or %x, the number.
+func (i {{.TypeName}}) Format(f fmt.State, verb rune) {
+	switch verb {
+	case 'v':
+		switch {
+		case f.Flag('#'):
+			fmt.Fprint(f, i.GoString())
+		case f.Flag('+') && i.IsA{{.TypeName}}():
+			fmt.Fprintf(f, "%s(%d)", i.String(), {{.NumberType}}(i))
+		default:
+			fmt.Fprintf(f, fmt.FormatString(f, 's'), i.String())
+		}
+	case 's', 'q':
+		fmt.Fprintf(f, fmt.FormatString(f, verb), i.String())
+	default:
+		fmt.Fprintf(f, fmt.FormatString(f, verb), {{.NumberType}}(i))
+	}
+}
+
+// GoString implements the fmt.GoStringer interface for {{.TypeName}}. It returns the
+// Go identifier of the value, such as {{.Package}}.{{.FirstGoName}}
+func (i {{.TypeName}}) GoString() string {
+	if name, ok := _{{.TypeName}}GoNames[i]; ok {
+		return "{{.Package}}." + name
+	}
+	return fmt.Sprintf("{{.Package}}.{{.TypeName}}(%d)", {{.NumberType}}(i))
+}
+`
+
+func init() {
+	registerBuiltin(Plugin{
+		Name:    IncludeFormat,
+		Generate: func(w io.Writer, e *Enum) error {
+			return e.g.executeTo(w, "formatMethods")
+		},
+	})
+}
+
+const iterFuncs = `
+// {{.TypeName}}All returns an iterator over all values of the enum, sorted by value
+func {{.TypeName}}All() iter.Seq[{{.TypeName}}] {
+	return func(yield func({{.TypeName}}) bool) {
+		for _, v := range _{{.TypeName}}Values {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// {{.TypeName}}Names returns an iterator over the string representations of all
+// values of the enum, sorted by value
+func {{.TypeName}}Names() iter.Seq[string] {
+	return func(yield func(string) bool) {
+		for _, v := range _{{.TypeName}}Values {
+			if !yield(v.String()) {
+				return
+			}
+		}
+	}
+}
+
+// {{.TypeName}}Pairs returns an iterator over the string representations and the
+// values of the enum, sorted by value
+func {{.TypeName}}Pairs() iter.Seq2[string, {{.TypeName}}] {
+	return func(yield func(string, {{.TypeName}}) bool) {
+		for _, v := range _{{.TypeName}}Values {
+			if !yield(v.String(), v) {
+				return
+			}
+		}
+	}
+}
+`
+
+func init() {
+	registerBuiltin(Plugin{
+		Name:    IncludeIter,
+		Imports: []string{"iter"},
+		Generate: func(w io.Writer, e *Enum) error {
+			return e.g.executeTo(w, "iterFuncs")
+		},
+	})
+}
+
+const enumMethods = `
+// IsValid implements the enum.Enum interface for {{.TypeName}}
+func (i {{.TypeName}}) IsValid() bool {
+	return i.IsA{{.TypeName}}()
+}
+
+// _{{.TypeName}}Descriptor implements the enum.Descriptor interface for {{.TypeName}}
+type _{{.TypeName}}Descriptor struct{}
+
+// Parse retrieves an enum value from the enum constants string name
+func (_{{.TypeName}}Descriptor) Parse(s string) ({{.TypeName}}, error) {
+	return {{.TypeName}}String(s)
+}
+
+// Values returns all values of the enum
+func (_{{.TypeName}}Descriptor) Values() []{{.TypeName}} {
+	return {{.TypeName}}Values()
+}
+
+// Descriptor implements the enum.Described interface for {{.TypeName}}, so the generic
+// functions of the enum package, such as enum.Parse[{{.TypeName}}], work with it
+func ({{.TypeName}}) Descriptor() enum.Descriptor[{{.TypeName}}] {
+	return _{{.TypeName}}Descriptor{}
+}
+`
+
+func init() {
+	registerBuiltin(Plugin{
+		Name:    IncludeEnum,
+		Imports: []string{"github.com/spectralogic/enumer/enum"},
+		Generate: func(w io.Writer, e *Enum) error {
+			return e.g.executeTo(w, "enumMethods")
+		},
+	})
+}
+
+// The register format generates an init function that registers the enum
+// type, with its values, in the registry of the enum package. The type is
+// registered under the import path of the package, which is unknown when the
+// package is made of a list of files: every such package would be registered
+// under the same path, so the registration of two of them would panic.
+func init() {
+	registerBuiltin(Plugin{
+		Name:    IncludeRegister,
+		Imports: []string{"github.com/spectralogic/enumer/enum"},
+		Generate: func(w io.Writer, e *Enum) error {
+			if path := e.g.pkg.path; path == "" || path == "command-line-arguments" {
+				return fmt.Errorf("-%s needs the import path of the package, which is unknown when files are listed", IncludeRegister)
+			}
+			return e.g.executeTo(w, "registerFunc")
+		},
+	})
+}
+
+const registerFunc = `
+func init() {
+	enum.Register(enum.Type{
+		Name:      {{printf "%q" .TypeName}},
+		Package:   {{printf "%q" .PackagePath}},
+		Transform: {{printf "%q" .Transform}},
+		Values: []enum.Value{
+{{- range .Values}}
+			{Name: {{printf "%q" .Name}}, Value: {{$.TypeName}}({{.Literal}}){{if .Comment}}, Comment: {{printf "%q" .Comment}}{{end}}},
+{{- end}}
+		},
+		Parse: func(s string) (any, error) {
+			v, err := {{.TypeName}}String(s)
+			if err != nil {
+				return nil, err
+			}
+			return v, nil
+		},
+	})
+}
+`
+
+const infoMethods = `
+var _{{.TypeName}}Info = []enum.ValueInfo[{{.TypeName}}]{
+{{- range .Values}}
+	{GoName: {{printf "%q" .GoName}}, Name: {{printf "%q" .Name}}, Value: {{$.TypeName}}({{.Literal}}){{if .Doc}}, Doc: {{printf "%q" .Doc}}{{end}}{{if .Comment}}, Comment: {{printf "%q" .Comment}}{{end}}},
+{{- end}}
+}
+
+// {{.TypeName}}Info returns the Go identifier, the string representation, the doc
+// comment and the line comment of all values of the enum, sorted by value
+func {{.TypeName}}Info() []enum.ValueInfo[{{.TypeName}}] {
+	info := make([]enum.ValueInfo[{{.TypeName}}], len(_{{.TypeName}}Info))
+	copy(info, _{{.TypeName}}Info)
+	return info
+}
+
+// GoName returns the name of the constant that declares the value in the Go
+// source, or "" if the value is not listed in the enum definition
+func (i {{.TypeName}}) GoName() string {
+	for _, v := range _{{.TypeName}}Info {
+		if v.Value == i {
+			return v.GoName
+		}
+	}
+	return ""
+}
+
+// Doc returns the doc comment of the constant that declares the value, or ""
+// if it has none
+func (i {{.TypeName}}) Doc() string {
+	for _, v := range _{{.TypeName}}Info {
+		if v.Value == i {
+			return v.Doc
+		}
+	}
+	return ""
+}
+`
+
+func init() {
+	registerBuiltin(Plugin{
+		Name:    IncludeInfo,
+		Imports: []string{"github.com/spectralogic/enumer/enum"},
+		Generate: func(w io.Writer, e *Enum) error {
+			return e.g.executeTo(w, "infoMethods")
+		},
+	})
+}
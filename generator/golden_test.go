@@ -0,0 +1,3796 @@
+// Copyright 2014 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// This file contains simple golden tests for various examples.
+// Besides validating the results when the implementation changes,
+// it provides a way to look at the generated code without having
+// to execute the print statements in one's head.
+
+package generator
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// Golden represents a test case.
+type Golden struct {
+	name    string
+	input   string // input; the package clause is provided when running the test.
+	output  string // expected output.
+	flags   map[string]bool
+	options map[string]string
+}
+
+var noFlags = map[string]bool{}
+var noOptions = map[string]string{}
+
+var golden = []Golden{
+	{"day", dayIn, dayOut, noFlags, noOptions},
+	{"offset", offsetIn, offsetOut, noFlags, noOptions},
+	{"gap", gapIn, gapOut, noFlags, noOptions},
+	{"num", numIn, numOut, noFlags, noOptions},
+	{"unum", unumIn, unumOut, noFlags, noOptions},
+	{"prime", primeIn, primeOut, noFlags, noOptions},
+	{"prime", primeJsonIn, primeJsonOut, map[string]bool{IncludeJSON: true}, noOptions},
+	{"prime", primeTextIn, primeTextOut, map[string]bool{IncludeText: true}, noOptions},
+	{"prime", primeYamlIn, primeYamlOut, map[string]bool{IncludeYAML: true}, noOptions},
+	{"prime", primeSqlIn, primeSqlOut, map[string]bool{IncludeSQL: true}, noOptions},
+	{"prime", primeJsonAndSqlIn, primeJsonAndSqlOut, map[string]bool{IncludeJSON: true, IncludeSQL: true}, noOptions},
+	{"day", dayIn, dayOut + dayBinaryOut, map[string]bool{IncludeBinary: true}, noOptions},
+	{"day", dayIn, dayOut + dayBinaryNameOut, map[string]bool{IncludeBinary: true}, map[string]string{BinaryEncoding: BinaryName}},
+	{"unum", unumIn, unumOut + unumBinaryOut, map[string]bool{IncludeBinary: true}, noOptions},
+	{"day", dayIn, dayOut + dayXMLOut, map[string]bool{IncludeXML: true}, noOptions},
+	{"day", dayIn, dayOut + dayYAMLv3Out, map[string]bool{IncludeYAML: true}, map[string]string{YAMLVersion: YAMLv3}},
+	{"day", dayIn, dayYAMLv3NumericOut, map[string]bool{IncludeYAML: true, AllowNumeric: true}, map[string]string{YAMLVersion: YAMLv3}},
+	{"day", dayIn, dayOut + dayGraphQLOut, map[string]bool{IncludeGraphQL: true}, noOptions},
+	{"day", dayIn, dayOut + daySqlIntOut, map[string]bool{IncludeSQL: true}, map[string]string{SQLStorage: SQLInt}},
+	{"day", dayIn, dayOut + daySqlIntOut + dayNullSqlOut, map[string]bool{IncludeSQL: true, IncludeNull: true}, map[string]string{SQLStorage: SQLInt}},
+	{"day", dayIn, dayOut + daySqlAndORMOut, map[string]bool{IncludeSQL: true}, map[string]string{ORMs: "gorm,ent"}},
+	{"day", dayIn, dayOut + daySqlIntOut + dayGormIntOut, map[string]bool{IncludeSQL: true}, map[string]string{SQLStorage: SQLInt, ORMs: ORMGorm}},
+	{"day", dayIn, dayOut + dayFlagOut, map[string]bool{IncludeFlag: true}, noOptions},
+	{"day", dayIn, dayOut + dayListOut, map[string]bool{IncludeList: true, ListDedupe: true}, map[string]string{ListSeparator: "|"}},
+	{"day", dayIn, dayOut + dayCompletionsOut, map[string]bool{IncludeCompletion: true}, noOptions},
+	{"day", dayIn, dayOut + daySlogOut, map[string]bool{IncludeSlog: true}, noOptions},
+	{"day", dayIn, dayOut + dayIterOut, map[string]bool{IncludeIter: true}, noOptions},
+	{"day", dayIn, strings.Replace(dayOut, dayValues, dayValuesCopy, 1), map[string]bool{ValuesCopy: true}, noOptions},
+	{"day", dayIn, dayOut + dayEnumOut, map[string]bool{IncludeEnum: true}, noOptions},
+	{"day", dayIn, dayOut + dayRegisterOut, map[string]bool{IncludeRegister: true}, noOptions},
+	{"level", levelDocIn, levelInfoOut, map[string]bool{IncludeInfo: true}, noOptions},
+	{"day", dayIn, dayOut + dayPluginOut, map[string]bool{"testplugin": true}, noOptions},
+	{"day", dayIn, dayOut + dayProtoOut, map[string]bool{IncludeProto: true}, noOptions},
+	{"day", dayIn, dayOut + dayFormatOut, map[string]bool{IncludeFormat: true}, noOptions},
+	{"day", dayIn, dayOut + daySlogGroupOut, map[string]bool{IncludeSlog: true, SlogGroup: true}, noOptions},
+	{"day", dayIn, dayOut + dayPGArrayOut, map[string]bool{IncludePGArray: true}, noOptions},
+	{"day", dayIn, dayNullPGArrayOut, map[string]bool{IncludePGArray: true, IncludeNull: true}, noOptions},
+	{"day", dayIn, dayOut + dayYAMLv3Out + dayNullYAMLv3Out, map[string]bool{IncludeYAML: true, IncludeNull: true}, map[string]string{YAMLVersion: YAMLv3}},
+	{"day", dayIn, dayNullDecodersOut, map[string]bool{IncludeNull: true, IncludeXML: true, IncludeBinary: true, IncludeGraphQL: true, IncludeFlag: true}, noOptions},
+	{"prefix", prefixIn, dayOut, noFlags, map[string]string{TrimPrefix: "Day"}},
+	{"camel", camelIn, camelOut, noFlags, noOptions},
+	{"camel", camelIn, strings.Replace(camelOut, camelString, strings.ToUpper(camelString), 1), noFlags, map[string]string{TransformMethod: ToUpper}},
+	{"camel", camelIn, strings.Replace(camelOut, camelString, strings.ToLower(camelString), 1), noFlags, map[string]string{TransformMethod: ToLower}},
+	{"camel", camelIn, strings.Replace(camelOut, camelString, camelJSON, 1), noFlags, map[string]string{TransformMethod: ToJSON}},
+	{"camel", camelIn, camelSnakeOut, noFlags, map[string]string{TransformMethod: ToSnake}},
+	{"camel", camelIn, strings.Replace(camelSnakeOut, camelSnake, strings.ToUpper(camelSnake), 1), noFlags, map[string]string{TransformMethod: ToSnakeUpper}},
+	{"camel", camelIn, strings.Replace(camelSnakeOut, camelSnake, camelKebab, 1), noFlags, map[string]string{TransformMethod: ToKebab}},
+	{"camel", camelIn, strings.Replace(camelSnakeOut, camelSnake, strings.ToUpper(camelKebab), 1), noFlags, map[string]string{TransformMethod: ToKebabUpper}},
+	{"camel", camelIn, camelIgnoreLowerOut, map[string]bool{IgnoreCase: true, IncludeJSON: true, AllowNumeric: true}, map[string]string{TransformMethod: ToLower}},
+	{"camel", camelIn, camelIgnoreUpperOut, map[string]bool{IgnoreCase: true, IncludeJSON: true, AllowNumeric: true}, map[string]string{TransformMethod: ToUpper}},
+	{"camel", camelIn, camelIgnoreJSONOut, map[string]bool{IgnoreCase: true, IncludeJSON: true, AllowNumeric: true}, map[string]string{TransformMethod: ToJSON}},
+	{"primer with line Comments", primeWithLineCommentIn, primeWithLineCommentOut, map[string]bool{LineComment: true}, noOptions},
+}
+
+// GoldenFile represents a test case for a side file, such as a schema,
+// written next to the Go output.
+type GoldenFile struct {
+	name    string
+	input   string // input; the package clause is provided when running the test.
+	file    string // name of the side file; the Go output is named <name>_string.go.
+	output  string // expected side file contents.
+	flags   map[string]bool
+	options map[string]string
+}
+
+var goldenFiles = []GoldenFile{
+	{"camel", camelIn, "camel_string.graphqls", camelGraphQLSchema, map[string]bool{GraphQLSchema: true}, map[string]string{TrimPrefix: "Enum", GraphQLCase: ToSnake}},
+	{"day", dayIn, "day_string.sql", dayPostgresDDL, noFlags, map[string]string{DDLDialect: DDLPostgres}},
+	{"camel", camelIn, "camel.sql", camelMySQLDDL, noFlags, map[string]string{DDLDialect: DDLMySQL, DDLOutput: "camel.sql", TransformMethod: ToKebab}},
+	{"camel", camelIn, "camel_string.sql", camelSQLiteDDL, noFlags, map[string]string{DDLDialect: DDLSQLite, TrimPrefix: "Enum"}},
+	{"level", levelIn, "level_string.bash", levelBash, noFlags, map[string]string{EmitShells: "bash,zsh,fish"}},
+	{"level", levelIn, "level_string.zsh", levelZsh, noFlags, map[string]string{EmitShells: "bash,zsh,fish"}},
+	{"level", levelIn, "level_string.fish", levelFish, noFlags, map[string]string{EmitShells: "bash,zsh,fish"}},
+	{"camel", camelIn, "schema/enums.graphqls", camelGraphQLSchema, map[string]bool{GraphQLSchema: true}, map[string]string{TrimPrefix: "Enum", GraphQLCase: ToSnake, GraphQLSchema: "schema/enums.graphqls"}},
+	{"level", levelDocIn, "level_string.ts", levelTS, map[string]bool{IncludeTS: true}, noOptions},
+	{"day", dayIn, "web/day.ts", dayObjectTS, map[string]bool{IncludeTS: true, AllowNumeric: true}, map[string]string{TSStyle: TSObject, IncludeTS: "web/day.ts", TransformMethod: ToSnake}},
+	{"big", bigIn, "big_string.ts", bigNumericTS, map[string]bool{IncludeTS: true, AllowNumeric: true}, noOptions},
+	{"level", levelIn, "level_string.schema.json", levelJSONSchema, map[string]bool{IncludeJSONSchema: true}, noOptions},
+	{"level", levelIn, "level_string.openapi.yaml", levelOpenAPI, map[string]bool{IncludeOpenAPI: true, IgnoreCase: true, AllowNumeric: true}, map[string]string{TransformMethod: ToLower}},
+	{"level", levelDocIn, "level_string.proto", levelProto, map[string]bool{IncludeProto: true}, noOptions},
+	{"level", levelIn, "level_string.proto", levelPackageProto, map[string]bool{IncludeProto: true}, map[string]string{ProtoPackage: "acme.logging.v1"}},
+	{"gap", gapIn, "gap_string.proto", gapProto, map[string]bool{IncludeProto: true}, noOptions},
+}
+
+// Each example starts with "type XXX [u]int", with a single space separating them.
+
+// Simple test: enumeration of type int starting at 0.
+const dayIn = `type Day int
+const (
+	Monday Day = iota
+	Tuesday
+	Wednesday
+	Thursday
+	Friday
+	Saturday
+	Sunday
+)
+`
+
+const dayOut = `
+const _DayName = "MondayTuesdayWednesdayThursdayFridaySaturdaySunday"
+
+var _DayIndex = [...]uint8{0, 6, 13, 22, 30, 36, 44, 50}
+
+func (i Day) String() string {
+	if i < 0 || i >= Day(len(_DayIndex)-1) {
+		return fmt.Sprintf("Day(%d)", i)
+	}
+	return _DayName[_DayIndex[i]:_DayIndex[i+1]]
+}
+
+var _DayValues = []Day{0, 1, 2, 3, 4, 5, 6}
+
+var _DayNameToValueMap = map[string]Day{
+	_DayName[0:6]:   0,
+	_DayName[6:13]:  1,
+	_DayName[13:22]: 2,
+	_DayName[22:30]: 3,
+	_DayName[30:36]: 4,
+	_DayName[36:44]: 5,
+	_DayName[44:50]: 6,
+}
+
+// DayString retrieves an enum value from the enum constants string name.
+// Throws an error if the param is not part of the enum.
+func DayString(s string) (Day, error) {
+	if val, ok := _DayNameToValueMap[s]; ok {
+		return val, nil
+	}
+	return 0, fmt.Errorf("%s does not belong to Day values", s)
+}
+
+// DayValues returns all values of the enum
+func DayValues() []Day {
+	return _DayValues
+}
+
+// IsADay returns "true" if the value is listed in the enum definition. "false" otherwise
+func (i Day) IsADay() bool {
+	for _, v := range _DayValues {
+		if i == v {
+			return true
+		}
+	}
+	return false
+}
+`
+
+// Camel case test: enumeration with camel case tags
+const camelIn = `type Camel int
+const (
+	EnumFirst Camel = iota
+	EnumSecond
+	EnumThird
+	EnumFourth
+	EnumFifth
+	EnumSixth
+	EnumSeventh
+)
+`
+
+const camelOut = `
+const _CamelName = "EnumFirstEnumSecondEnumThirdEnumFourthEnumFifthEnumSixthEnumSeventh"
+
+var _CamelIndex = [...]uint8{0, 9, 19, 28, 38, 47, 56, 67}
+
+func (i Camel) String() string {
+	if i < 0 || i >= Camel(len(_CamelIndex)-1) {
+		return fmt.Sprintf("Camel(%d)", i)
+	}
+	return _CamelName[_CamelIndex[i]:_CamelIndex[i+1]]
+}
+
+var _CamelValues = []Camel{0, 1, 2, 3, 4, 5, 6}
+
+var _CamelNameToValueMap = map[string]Camel{
+	_CamelName[0:9]:   0,
+	_CamelName[9:19]:  1,
+	_CamelName[19:28]: 2,
+	_CamelName[28:38]: 3,
+	_CamelName[38:47]: 4,
+	_CamelName[47:56]: 5,
+	_CamelName[56:67]: 6,
+}
+
+// CamelString retrieves an enum value from the enum constants string name.
+// Throws an error if the param is not part of the enum.
+func CamelString(s string) (Camel, error) {
+	if val, ok := _CamelNameToValueMap[s]; ok {
+		return val, nil
+	}
+	return 0, fmt.Errorf("%s does not belong to Camel values", s)
+}
+
+// CamelValues returns all values of the enum
+func CamelValues() []Camel {
+	return _CamelValues
+}
+
+// IsACamel returns "true" if the value is listed in the enum definition. "false" otherwise
+func (i Camel) IsACamel() bool {
+	for _, v := range _CamelValues {
+		if i == v {
+			return true
+		}
+	}
+	return false
+}
+`
+
+const camelIgnoreLowerOut = `
+const _CamelName = "enumfirstenumsecondenumthirdenumfourthenumfifthenumsixthenumseventh"
+
+var _CamelIndex = [...]uint8{0, 9, 19, 28, 38, 47, 56, 67}
+
+func (i Camel) String() string {
+	if i < 0 || i >= Camel(len(_CamelIndex)-1) {
+		return fmt.Sprintf("Camel(%d)", i)
+	}
+	return _CamelName[_CamelIndex[i]:_CamelIndex[i+1]]
+}
+
+var _CamelValues = []Camel{0, 1, 2, 3, 4, 5, 6}
+
+var _CamelNameToValueMap = map[string]Camel{
+	_CamelName[0:9]:   0,
+	_CamelName[9:19]:  1,
+	_CamelName[19:28]: 2,
+	_CamelName[28:38]: 3,
+	_CamelName[38:47]: 4,
+	_CamelName[47:56]: 5,
+	_CamelName[56:67]: 6,
+}
+
+// CamelString retrieves an enum value from the enum constants string name.
+// Throws an error if the param is not part of the enum.
+func CamelString(s string) (Camel, error) {
+	if val, ok := _CamelNameToValueMap[strings.ToLower(s)]; ok {
+		return val, nil
+	}
+	i, err := strconv.Atoi(s)
+	if err == nil {
+		for _, v := range _CamelNameToValueMap {
+			if int(v) == i {
+				return v, nil
+			}
+		}
+	}
+	return 0, fmt.Errorf("%s does not belong to Camel values", s)
+}
+
+// CamelValues returns all values of the enum
+func CamelValues() []Camel {
+	return _CamelValues
+}
+
+// IsACamel returns "true" if the value is listed in the enum definition. "false" otherwise
+func (i Camel) IsACamel() bool {
+	for _, v := range _CamelValues {
+		if i == v {
+			return true
+		}
+	}
+	return false
+}
+
+// MarshalJSON implements the json.Marshaler interface for Camel
+func (i Camel) MarshalJSON() ([]byte, error) {
+	return json.Marshal(i.String())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface for Camel
+func (i *Camel) UnmarshalJSON(data []byte) error {
+	var s string
+	var err error
+	if err = json.Unmarshal(data, &s); err != nil {
+		var val int
+		if err = json.Unmarshal(data, &val); err != nil {
+			return fmt.Errorf("Camel should be a string, got %s", data)
+		}
+		*i = Camel(val)
+		if !i.IsACamel() {
+			return fmt.Errorf("Invalid value for Camel (%d)", val)
+		}
+		return nil
+	}
+
+	*i, err = CamelString(s)
+	return err
+}
+`
+
+const camelIgnoreUpperOut = `
+const _CamelName = "ENUMFIRSTENUMSECONDENUMTHIRDENUMFOURTHENUMFIFTHENUMSIXTHENUMSEVENTH"
+
+var _CamelIndex = [...]uint8{0, 9, 19, 28, 38, 47, 56, 67}
+
+func (i Camel) String() string {
+	if i < 0 || i >= Camel(len(_CamelIndex)-1) {
+		return fmt.Sprintf("Camel(%d)", i)
+	}
+	return _CamelName[_CamelIndex[i]:_CamelIndex[i+1]]
+}
+
+var _CamelValues = []Camel{0, 1, 2, 3, 4, 5, 6}
+
+var _CamelNameToValueMap = map[string]Camel{
+	_CamelName[0:9]:   0,
+	_CamelName[9:19]:  1,
+	_CamelName[19:28]: 2,
+	_CamelName[28:38]: 3,
+	_CamelName[38:47]: 4,
+	_CamelName[47:56]: 5,
+	_CamelName[56:67]: 6,
+}
+
+// CamelString retrieves an enum value from the enum constants string name.
+// Throws an error if the param is not part of the enum.
+func CamelString(s string) (Camel, error) {
+	if val, ok := _CamelNameToValueMap[strings.ToUpper(s)]; ok {
+		return val, nil
+	}
+	i, err := strconv.Atoi(s)
+	if err == nil {
+		for _, v := range _CamelNameToValueMap {
+			if int(v) == i {
+				return v, nil
+			}
+		}
+	}
+	return 0, fmt.Errorf("%s does not belong to Camel values", s)
+}
+
+// CamelValues returns all values of the enum
+func CamelValues() []Camel {
+	return _CamelValues
+}
+
+// IsACamel returns "true" if the value is listed in the enum definition. "false" otherwise
+func (i Camel) IsACamel() bool {
+	for _, v := range _CamelValues {
+		if i == v {
+			return true
+		}
+	}
+	return false
+}
+
+// MarshalJSON implements the json.Marshaler interface for Camel
+func (i Camel) MarshalJSON() ([]byte, error) {
+	return json.Marshal(i.String())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface for Camel
+func (i *Camel) UnmarshalJSON(data []byte) error {
+	var s string
+	var err error
+	if err = json.Unmarshal(data, &s); err != nil {
+		var val int
+		if err = json.Unmarshal(data, &val); err != nil {
+			return fmt.Errorf("Camel should be a string, got %s", data)
+		}
+		*i = Camel(val)
+		if !i.IsACamel() {
+			return fmt.Errorf("Invalid value for Camel (%d)", val)
+		}
+		return nil
+	}
+
+	*i, err = CamelString(s)
+	return err
+}
+`
+
+const camelIgnoreJSONOut = `
+const _CamelName = "enumFirstenumSecondenumThirdenumFourthenumFifthenumSixthenumSeventh"
+
+var _CamelIndex = [...]uint8{0, 9, 19, 28, 38, 47, 56, 67}
+
+func (i Camel) String() string {
+	if i < 0 || i >= Camel(len(_CamelIndex)-1) {
+		return fmt.Sprintf("Camel(%d)", i)
+	}
+	return _CamelName[_CamelIndex[i]:_CamelIndex[i+1]]
+}
+
+var _CamelValues = []Camel{0, 1, 2, 3, 4, 5, 6}
+
+var _CamelNameToValueMap = map[string]Camel{
+	_CamelName[0:9]:   0,
+	_CamelName[9:19]:  1,
+	_CamelName[19:28]: 2,
+	_CamelName[28:38]: 3,
+	_CamelName[38:47]: 4,
+	_CamelName[47:56]: 5,
+	_CamelName[56:67]: 6,
+}
+
+// CamelString retrieves an enum value from the enum constants string name.
+// Throws an error if the param is not part of the enum.
+func CamelString(s string) (Camel, error) {
+	if val, ok := _CamelNameToValueMap[s]; ok {
+		return val, nil
+	}
+	for k, v := range _CamelNameToValueMap {
+		if strings.EqualFold(s, k) {
+			return v, nil
+		}
+	}
+	i, err := strconv.Atoi(s)
+	if err == nil {
+		for _, v := range _CamelNameToValueMap {
+			if int(v) == i {
+				return v, nil
+			}
+		}
+	}
+	return 0, fmt.Errorf("%s does not belong to Camel values", s)
+}
+
+// CamelValues returns all values of the enum
+func CamelValues() []Camel {
+	return _CamelValues
+}
+
+// IsACamel returns "true" if the value is listed in the enum definition. "false" otherwise
+func (i Camel) IsACamel() bool {
+	for _, v := range _CamelValues {
+		if i == v {
+			return true
+		}
+	}
+	return false
+}
+
+// MarshalJSON implements the json.Marshaler interface for Camel
+func (i Camel) MarshalJSON() ([]byte, error) {
+	return json.Marshal(i.String())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface for Camel
+func (i *Camel) UnmarshalJSON(data []byte) error {
+	var s string
+	var err error
+	if err = json.Unmarshal(data, &s); err != nil {
+		var val int
+		if err = json.Unmarshal(data, &val); err != nil {
+			return fmt.Errorf("Camel should be a string, got %s", data)
+		}
+		*i = Camel(val)
+		if !i.IsACamel() {
+			return fmt.Errorf("Invalid value for Camel (%d)", val)
+		}
+		return nil
+	}
+
+	*i, err = CamelString(s)
+	return err
+}
+`
+
+const camelSnakeOut = `
+const _CamelName = "enum_firstenum_secondenum_thirdenum_fourthenum_fifthenum_sixthenum_seventh"
+
+var _CamelIndex = [...]uint8{0, 10, 21, 31, 42, 52, 62, 74}
+
+func (i Camel) String() string {
+	if i < 0 || i >= Camel(len(_CamelIndex)-1) {
+		return fmt.Sprintf("Camel(%d)", i)
+	}
+	return _CamelName[_CamelIndex[i]:_CamelIndex[i+1]]
+}
+
+var _CamelValues = []Camel{0, 1, 2, 3, 4, 5, 6}
+
+var _CamelNameToValueMap = map[string]Camel{
+	_CamelName[0:10]:  0,
+	_CamelName[10:21]: 1,
+	_CamelName[21:31]: 2,
+	_CamelName[31:42]: 3,
+	_CamelName[42:52]: 4,
+	_CamelName[52:62]: 5,
+	_CamelName[62:74]: 6,
+}
+
+// CamelString retrieves an enum value from the enum constants string name.
+// Throws an error if the param is not part of the enum.
+func CamelString(s string) (Camel, error) {
+	if val, ok := _CamelNameToValueMap[s]; ok {
+		return val, nil
+	}
+	return 0, fmt.Errorf("%s does not belong to Camel values", s)
+}
+
+// CamelValues returns all values of the enum
+func CamelValues() []Camel {
+	return _CamelValues
+}
+
+// IsACamel returns "true" if the value is listed in the enum definition. "false" otherwise
+func (i Camel) IsACamel() bool {
+	for _, v := range _CamelValues {
+		if i == v {
+			return true
+		}
+	}
+	return false
+}
+`
+
+const camelString = "EnumFirstEnumSecondEnumThirdEnumFourthEnumFifthEnumSixthEnumSeventh"
+const camelJSON = "enumFirstenumSecondenumThirdenumFourthenumFifthenumSixthenumSeventh"
+const camelSnake = "enum_firstenum_secondenum_thirdenum_fourthenum_fifthenum_sixthenum_seventh"
+const camelKebab = "enum-firstenum-secondenum-thirdenum-fourthenum-fifthenum-sixthenum-seventh"
+
+// Enumeration with an offset.
+// Also includes a duplicate.
+const offsetIn = `type Number int
+const (
+	_ Number = iota
+	One
+	Two
+	Three
+	AnotherOne = One  // Duplicate; note that AnotherOne doesn't appear below.
+)
+`
+
+const offsetOut = `
+const _NumberName = "OneTwoThree"
+
+var _NumberIndex = [...]uint8{0, 3, 6, 11}
+
+func (i Number) String() string {
+	i -= 1
+	if i < 0 || i >= Number(len(_NumberIndex)-1) {
+		return fmt.Sprintf("Number(%d)", i+1)
+	}
+	return _NumberName[_NumberIndex[i]:_NumberIndex[i+1]]
+}
+
+var _NumberValues = []Number{1, 2, 3}
+
+var _NumberNameToValueMap = map[string]Number{
+	_NumberName[0:3]:  1,
+	_NumberName[3:6]:  2,
+	_NumberName[6:11]: 3,
+}
+
+// NumberString retrieves an enum value from the enum constants string name.
+// Throws an error if the param is not part of the enum.
+func NumberString(s string) (Number, error) {
+	if val, ok := _NumberNameToValueMap[s]; ok {
+		return val, nil
+	}
+	return 0, fmt.Errorf("%s does not belong to Number values", s)
+}
+
+// NumberValues returns all values of the enum
+func NumberValues() []Number {
+	return _NumberValues
+}
+
+// IsANumber returns "true" if the value is listed in the enum definition. "false" otherwise
+func (i Number) IsANumber() bool {
+	for _, v := range _NumberValues {
+		if i == v {
+			return true
+		}
+	}
+	return false
+}
+`
+
+// Gaps and an offset.
+const gapIn = `type Gap int
+const (
+	Two Gap = 2
+	Three Gap = 3
+	Five Gap = 5
+	Six Gap = 6
+	Seven Gap = 7
+	Eight Gap = 8
+	Nine Gap = 9
+	Eleven Gap = 11
+)
+`
+
+const gapOut = `
+const (
+	_GapName_0 = "TwoThree"
+	_GapName_1 = "FiveSixSevenEightNine"
+	_GapName_2 = "Eleven"
+)
+
+var (
+	_GapIndex_0 = [...]uint8{0, 3, 8}
+	_GapIndex_1 = [...]uint8{0, 4, 7, 12, 17, 21}
+	_GapIndex_2 = [...]uint8{0, 6}
+)
+
+func (i Gap) String() string {
+	switch {
+	case 2 <= i && i <= 3:
+		i -= 2
+		return _GapName_0[_GapIndex_0[i]:_GapIndex_0[i+1]]
+	case 5 <= i && i <= 9:
+		i -= 5
+		return _GapName_1[_GapIndex_1[i]:_GapIndex_1[i+1]]
+	case i == 11:
+		return _GapName_2
+	default:
+		return fmt.Sprintf("Gap(%d)", i)
+	}
+}
+
+var _GapValues = []Gap{2, 3, 5, 6, 7, 8, 9, 11}
+
+var _GapNameToValueMap = map[string]Gap{
+	_GapName_0[0:3]:   2,
+	_GapName_0[3:8]:   3,
+	_GapName_1[0:4]:   5,
+	_GapName_1[4:7]:   6,
+	_GapName_1[7:12]:  7,
+	_GapName_1[12:17]: 8,
+	_GapName_1[17:21]: 9,
+	_GapName_2[0:6]:   11,
+}
+
+// GapString retrieves an enum value from the enum constants string name.
+// Throws an error if the param is not part of the enum.
+func GapString(s string) (Gap, error) {
+	if val, ok := _GapNameToValueMap[s]; ok {
+		return val, nil
+	}
+	return 0, fmt.Errorf("%s does not belong to Gap values", s)
+}
+
+// GapValues returns all values of the enum
+func GapValues() []Gap {
+	return _GapValues
+}
+
+// IsAGap returns "true" if the value is listed in the enum definition. "false" otherwise
+func (i Gap) IsAGap() bool {
+	for _, v := range _GapValues {
+		if i == v {
+			return true
+		}
+	}
+	return false
+}
+`
+
+// Signed integers spanning zero.
+const numIn = `type Num int
+const (
+	m_2 Num = -2 + iota
+	m_1
+	m0
+	m1
+	m2
+)
+`
+
+const numOut = `
+const _NumName = "m_2m_1m0m1m2"
+
+var _NumIndex = [...]uint8{0, 3, 6, 8, 10, 12}
+
+func (i Num) String() string {
+	i -= -2
+	if i < 0 || i >= Num(len(_NumIndex)-1) {
+		return fmt.Sprintf("Num(%d)", i+-2)
+	}
+	return _NumName[_NumIndex[i]:_NumIndex[i+1]]
+}
+
+var _NumValues = []Num{-2, -1, 0, 1, 2}
+
+var _NumNameToValueMap = map[string]Num{
+	_NumName[0:3]:   -2,
+	_NumName[3:6]:   -1,
+	_NumName[6:8]:   0,
+	_NumName[8:10]:  1,
+	_NumName[10:12]: 2,
+}
+
+// NumString retrieves an enum value from the enum constants string name.
+// Throws an error if the param is not part of the enum.
+func NumString(s string) (Num, error) {
+	if val, ok := _NumNameToValueMap[s]; ok {
+		return val, nil
+	}
+	return 0, fmt.Errorf("%s does not belong to Num values", s)
+}
+
+// NumValues returns all values of the enum
+func NumValues() []Num {
+	return _NumValues
+}
+
+// IsANum returns "true" if the value is listed in the enum definition. "false" otherwise
+func (i Num) IsANum() bool {
+	for _, v := range _NumValues {
+		if i == v {
+			return true
+		}
+	}
+	return false
+}
+`
+
+// Unsigned integers spanning zero.
+const unumIn = `type Unum uint
+const (
+	m_2 Unum = iota + 253
+	m_1
+)
+
+const (
+	m0 Unum = iota
+	m1
+	m2
+)
+`
+
+const unumOut = `
+const (
+	_UnumName_0 = "m0m1m2"
+	_UnumName_1 = "m_2m_1"
+)
+
+var (
+	_UnumIndex_0 = [...]uint8{0, 2, 4, 6}
+	_UnumIndex_1 = [...]uint8{0, 3, 6}
+)
+
+func (i Unum) String() string {
+	switch {
+	case 0 <= i && i <= 2:
+		return _UnumName_0[_UnumIndex_0[i]:_UnumIndex_0[i+1]]
+	case 253 <= i && i <= 254:
+		i -= 253
+		return _UnumName_1[_UnumIndex_1[i]:_UnumIndex_1[i+1]]
+	default:
+		return fmt.Sprintf("Unum(%d)", i)
+	}
+}
+
+var _UnumValues = []Unum{0, 1, 2, 253, 254}
+
+var _UnumNameToValueMap = map[string]Unum{
+	_UnumName_0[0:2]: 0,
+	_UnumName_0[2:4]: 1,
+	_UnumName_0[4:6]: 2,
+	_UnumName_1[0:3]: 253,
+	_UnumName_1[3:6]: 254,
+}
+
+// UnumString retrieves an enum value from the enum constants string name.
+// Throws an error if the param is not part of the enum.
+func UnumString(s string) (Unum, error) {
+	if val, ok := _UnumNameToValueMap[s]; ok {
+		return val, nil
+	}
+	return 0, fmt.Errorf("%s does not belong to Unum values", s)
+}
+
+// UnumValues returns all values of the enum
+func UnumValues() []Unum {
+	return _UnumValues
+}
+
+// IsAUnum returns "true" if the value is listed in the enum definition. "false" otherwise
+func (i Unum) IsAUnum() bool {
+	for _, v := range _UnumValues {
+		if i == v {
+			return true
+		}
+	}
+	return false
+}
+`
+
+// Enough gaps to trigger a map implementation of the method.
+// Also includes a duplicate to test that it doesn't cause problems
+const primeIn = `type Prime int
+const (
+	p2 Prime = 2
+	p3 Prime = 3
+	p5 Prime = 5
+	p7 Prime = 7
+	p77 Prime = 7 // Duplicate; note that p77 doesn't appear below.
+	p11 Prime = 11
+	p13 Prime = 13
+	p17 Prime = 17
+	p19 Prime = 19
+	p23 Prime = 23
+	p29 Prime = 29
+	p37 Prime = 31
+	p41 Prime = 41
+	p43 Prime = 43
+)
+`
+
+const primeOut = `
+const _PrimeName = "p2p3p5p7p11p13p17p19p23p29p37p41p43"
+
+var _PrimeMap = map[Prime]string{
+	2:  _PrimeName[0:2],
+	3:  _PrimeName[2:4],
+	5:  _PrimeName[4:6],
+	7:  _PrimeName[6:8],
+	11: _PrimeName[8:11],
+	13: _PrimeName[11:14],
+	17: _PrimeName[14:17],
+	19: _PrimeName[17:20],
+	23: _PrimeName[20:23],
+	29: _PrimeName[23:26],
+	31: _PrimeName[26:29],
+	41: _PrimeName[29:32],
+	43: _PrimeName[32:35],
+}
+
+func (i Prime) String() string {
+	if str, ok := _PrimeMap[i]; ok {
+		return str
+	}
+	return fmt.Sprintf("Prime(%d)", i)
+}
+
+var _PrimeValues = []Prime{2, 3, 5, 7, 11, 13, 17, 19, 23, 29, 31, 41, 43}
+
+var _PrimeNameToValueMap = map[string]Prime{
+	_PrimeName[0:2]:   2,
+	_PrimeName[2:4]:   3,
+	_PrimeName[4:6]:   5,
+	_PrimeName[6:8]:   7,
+	_PrimeName[8:11]:  11,
+	_PrimeName[11:14]: 13,
+	_PrimeName[14:17]: 17,
+	_PrimeName[17:20]: 19,
+	_PrimeName[20:23]: 23,
+	_PrimeName[23:26]: 29,
+	_PrimeName[26:29]: 31,
+	_PrimeName[29:32]: 41,
+	_PrimeName[32:35]: 43,
+}
+
+// PrimeString retrieves an enum value from the enum constants string name.
+// Throws an error if the param is not part of the enum.
+func PrimeString(s string) (Prime, error) {
+	if val, ok := _PrimeNameToValueMap[s]; ok {
+		return val, nil
+	}
+	return 0, fmt.Errorf("%s does not belong to Prime values", s)
+}
+
+// PrimeValues returns all values of the enum
+func PrimeValues() []Prime {
+	return _PrimeValues
+}
+
+// IsAPrime returns "true" if the value is listed in the enum definition. "false" otherwise
+func (i Prime) IsAPrime() bool {
+	_, ok := _PrimeMap[i]
+	return ok
+}
+`
+const primeJsonIn = `type Prime int
+const (
+	p2 Prime = 2
+	p3 Prime = 3
+	p5 Prime = 5
+	p7 Prime = 7
+	p77 Prime = 7 // Duplicate; note that p77 doesn't appear below.
+	p11 Prime = 11
+	p13 Prime = 13
+	p17 Prime = 17
+	p19 Prime = 19
+	p23 Prime = 23
+	p29 Prime = 29
+	p37 Prime = 31
+	p41 Prime = 41
+	p43 Prime = 43
+)
+`
+
+const primeJsonOut = `
+const _PrimeName = "p2p3p5p7p11p13p17p19p23p29p37p41p43"
+
+var _PrimeMap = map[Prime]string{
+	2:  _PrimeName[0:2],
+	3:  _PrimeName[2:4],
+	5:  _PrimeName[4:6],
+	7:  _PrimeName[6:8],
+	11: _PrimeName[8:11],
+	13: _PrimeName[11:14],
+	17: _PrimeName[14:17],
+	19: _PrimeName[17:20],
+	23: _PrimeName[20:23],
+	29: _PrimeName[23:26],
+	31: _PrimeName[26:29],
+	41: _PrimeName[29:32],
+	43: _PrimeName[32:35],
+}
+
+func (i Prime) String() string {
+	if str, ok := _PrimeMap[i]; ok {
+		return str
+	}
+	return fmt.Sprintf("Prime(%d)", i)
+}
+
+var _PrimeValues = []Prime{2, 3, 5, 7, 11, 13, 17, 19, 23, 29, 31, 41, 43}
+
+var _PrimeNameToValueMap = map[string]Prime{
+	_PrimeName[0:2]:   2,
+	_PrimeName[2:4]:   3,
+	_PrimeName[4:6]:   5,
+	_PrimeName[6:8]:   7,
+	_PrimeName[8:11]:  11,
+	_PrimeName[11:14]: 13,
+	_PrimeName[14:17]: 17,
+	_PrimeName[17:20]: 19,
+	_PrimeName[20:23]: 23,
+	_PrimeName[23:26]: 29,
+	_PrimeName[26:29]: 31,
+	_PrimeName[29:32]: 41,
+	_PrimeName[32:35]: 43,
+}
+
+// PrimeString retrieves an enum value from the enum constants string name.
+// Throws an error if the param is not part of the enum.
+func PrimeString(s string) (Prime, error) {
+	if val, ok := _PrimeNameToValueMap[s]; ok {
+		return val, nil
+	}
+	return 0, fmt.Errorf("%s does not belong to Prime values", s)
+}
+
+// PrimeValues returns all values of the enum
+func PrimeValues() []Prime {
+	return _PrimeValues
+}
+
+// IsAPrime returns "true" if the value is listed in the enum definition. "false" otherwise
+func (i Prime) IsAPrime() bool {
+	_, ok := _PrimeMap[i]
+	return ok
+}
+
+// MarshalJSON implements the json.Marshaler interface for Prime
+func (i Prime) MarshalJSON() ([]byte, error) {
+	return json.Marshal(i.String())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface for Prime
+func (i *Prime) UnmarshalJSON(data []byte) error {
+	var s string
+	var err error
+	if err = json.Unmarshal(data, &s); err != nil {
+		return fmt.Errorf("Prime should be a string, got %s", data)
+	}
+
+	*i, err = PrimeString(s)
+	return err
+}
+`
+
+const primeTextIn = `type Prime int
+const (
+	p2 Prime = 2
+	p3 Prime = 3
+	p5 Prime = 5
+	p7 Prime = 7
+	p77 Prime = 7 // Duplicate; note that p77 doesn't appear below.
+	p11 Prime = 11
+	p13 Prime = 13
+	p17 Prime = 17
+	p19 Prime = 19
+	p23 Prime = 23
+	p29 Prime = 29
+	p37 Prime = 31
+	p41 Prime = 41
+	p43 Prime = 43
+)
+`
+
+const primeTextOut = `
+const _PrimeName = "p2p3p5p7p11p13p17p19p23p29p37p41p43"
+
+var _PrimeMap = map[Prime]string{
+	2:  _PrimeName[0:2],
+	3:  _PrimeName[2:4],
+	5:  _PrimeName[4:6],
+	7:  _PrimeName[6:8],
+	11: _PrimeName[8:11],
+	13: _PrimeName[11:14],
+	17: _PrimeName[14:17],
+	19: _PrimeName[17:20],
+	23: _PrimeName[20:23],
+	29: _PrimeName[23:26],
+	31: _PrimeName[26:29],
+	41: _PrimeName[29:32],
+	43: _PrimeName[32:35],
+}
+
+func (i Prime) String() string {
+	if str, ok := _PrimeMap[i]; ok {
+		return str
+	}
+	return fmt.Sprintf("Prime(%d)", i)
+}
+
+var _PrimeValues = []Prime{2, 3, 5, 7, 11, 13, 17, 19, 23, 29, 31, 41, 43}
+
+var _PrimeNameToValueMap = map[string]Prime{
+	_PrimeName[0:2]:   2,
+	_PrimeName[2:4]:   3,
+	_PrimeName[4:6]:   5,
+	_PrimeName[6:8]:   7,
+	_PrimeName[8:11]:  11,
+	_PrimeName[11:14]: 13,
+	_PrimeName[14:17]: 17,
+	_PrimeName[17:20]: 19,
+	_PrimeName[20:23]: 23,
+	_PrimeName[23:26]: 29,
+	_PrimeName[26:29]: 31,
+	_PrimeName[29:32]: 41,
+	_PrimeName[32:35]: 43,
+}
+
+// PrimeString retrieves an enum value from the enum constants string name.
+// Throws an error if the param is not part of the enum.
+func PrimeString(s string) (Prime, error) {
+	if val, ok := _PrimeNameToValueMap[s]; ok {
+		return val, nil
+	}
+	return 0, fmt.Errorf("%s does not belong to Prime values", s)
+}
+
+// PrimeValues returns all values of the enum
+func PrimeValues() []Prime {
+	return _PrimeValues
+}
+
+// IsAPrime returns "true" if the value is listed in the enum definition. "false" otherwise
+func (i Prime) IsAPrime() bool {
+	_, ok := _PrimeMap[i]
+	return ok
+}
+
+// MarshalText implements the encoding.TextMarshaler interface for Prime
+func (i Prime) MarshalText() ([]byte, error) {
+	return []byte(i.String()), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface for Prime
+func (i *Prime) UnmarshalText(text []byte) error {
+	var err error
+	*i, err = PrimeString(string(text))
+	return err
+}
+`
+
+const primeYamlIn = `type Prime int
+const (
+	p2 Prime = 2
+	p3 Prime = 3
+	p5 Prime = 5
+	p7 Prime = 7
+	p77 Prime = 7 // Duplicate; note that p77 doesn't appear below.
+	p11 Prime = 11
+	p13 Prime = 13
+	p17 Prime = 17
+	p19 Prime = 19
+	p23 Prime = 23
+	p29 Prime = 29
+	p37 Prime = 31
+	p41 Prime = 41
+	p43 Prime = 43
+)
+`
+
+const primeYamlOut = `
+const _PrimeName = "p2p3p5p7p11p13p17p19p23p29p37p41p43"
+
+var _PrimeMap = map[Prime]string{
+	2:  _PrimeName[0:2],
+	3:  _PrimeName[2:4],
+	5:  _PrimeName[4:6],
+	7:  _PrimeName[6:8],
+	11: _PrimeName[8:11],
+	13: _PrimeName[11:14],
+	17: _PrimeName[14:17],
+	19: _PrimeName[17:20],
+	23: _PrimeName[20:23],
+	29: _PrimeName[23:26],
+	31: _PrimeName[26:29],
+	41: _PrimeName[29:32],
+	43: _PrimeName[32:35],
+}
+
+func (i Prime) String() string {
+	if str, ok := _PrimeMap[i]; ok {
+		return str
+	}
+	return fmt.Sprintf("Prime(%d)", i)
+}
+
+var _PrimeValues = []Prime{2, 3, 5, 7, 11, 13, 17, 19, 23, 29, 31, 41, 43}
+
+var _PrimeNameToValueMap = map[string]Prime{
+	_PrimeName[0:2]:   2,
+	_PrimeName[2:4]:   3,
+	_PrimeName[4:6]:   5,
+	_PrimeName[6:8]:   7,
+	_PrimeName[8:11]:  11,
+	_PrimeName[11:14]: 13,
+	_PrimeName[14:17]: 17,
+	_PrimeName[17:20]: 19,
+	_PrimeName[20:23]: 23,
+	_PrimeName[23:26]: 29,
+	_PrimeName[26:29]: 31,
+	_PrimeName[29:32]: 41,
+	_PrimeName[32:35]: 43,
+}
+
+// PrimeString retrieves an enum value from the enum constants string name.
+// Throws an error if the param is not part of the enum.
+func PrimeString(s string) (Prime, error) {
+	if val, ok := _PrimeNameToValueMap[s]; ok {
+		return val, nil
+	}
+	return 0, fmt.Errorf("%s does not belong to Prime values", s)
+}
+
+// PrimeValues returns all values of the enum
+func PrimeValues() []Prime {
+	return _PrimeValues
+}
+
+// IsAPrime returns "true" if the value is listed in the enum definition. "false" otherwise
+func (i Prime) IsAPrime() bool {
+	_, ok := _PrimeMap[i]
+	return ok
+}
+
+// MarshalYAML implements a YAML Marshaler for Prime
+func (i Prime) MarshalYAML() (interface{}, error) {
+	return i.String(), nil
+}
+
+// UnmarshalYAML implements a YAML Unmarshaler for Prime
+func (i *Prime) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s string
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+
+	var err error
+	*i, err = PrimeString(s)
+	return err
+}
+`
+
+const primeSqlIn = `type Prime int
+const (
+	p2 Prime = 2
+	p3 Prime = 3
+	p5 Prime = 5
+	p7 Prime = 7
+	p77 Prime = 7 // Duplicate; note that p77 doesn't appear below.
+	p11 Prime = 11
+	p13 Prime = 13
+	p17 Prime = 17
+	p19 Prime = 19
+	p23 Prime = 23
+	p29 Prime = 29
+	p37 Prime = 31
+	p41 Prime = 41
+	p43 Prime = 43
+)
+`
+
+const primeSqlOut = `
+const _PrimeName = "p2p3p5p7p11p13p17p19p23p29p37p41p43"
+
+var _PrimeMap = map[Prime]string{
+	2:  _PrimeName[0:2],
+	3:  _PrimeName[2:4],
+	5:  _PrimeName[4:6],
+	7:  _PrimeName[6:8],
+	11: _PrimeName[8:11],
+	13: _PrimeName[11:14],
+	17: _PrimeName[14:17],
+	19: _PrimeName[17:20],
+	23: _PrimeName[20:23],
+	29: _PrimeName[23:26],
+	31: _PrimeName[26:29],
+	41: _PrimeName[29:32],
+	43: _PrimeName[32:35],
+}
+
+func (i Prime) String() string {
+	if str, ok := _PrimeMap[i]; ok {
+		return str
+	}
+	return fmt.Sprintf("Prime(%d)", i)
+}
+
+var _PrimeValues = []Prime{2, 3, 5, 7, 11, 13, 17, 19, 23, 29, 31, 41, 43}
+
+var _PrimeNameToValueMap = map[string]Prime{
+	_PrimeName[0:2]:   2,
+	_PrimeName[2:4]:   3,
+	_PrimeName[4:6]:   5,
+	_PrimeName[6:8]:   7,
+	_PrimeName[8:11]:  11,
+	_PrimeName[11:14]: 13,
+	_PrimeName[14:17]: 17,
+	_PrimeName[17:20]: 19,
+	_PrimeName[20:23]: 23,
+	_PrimeName[23:26]: 29,
+	_PrimeName[26:29]: 31,
+	_PrimeName[29:32]: 41,
+	_PrimeName[32:35]: 43,
+}
+
+// PrimeString retrieves an enum value from the enum constants string name.
+// Throws an error if the param is not part of the enum.
+func PrimeString(s string) (Prime, error) {
+	if val, ok := _PrimeNameToValueMap[s]; ok {
+		return val, nil
+	}
+	return 0, fmt.Errorf("%s does not belong to Prime values", s)
+}
+
+// PrimeValues returns all values of the enum
+func PrimeValues() []Prime {
+	return _PrimeValues
+}
+
+// IsAPrime returns "true" if the value is listed in the enum definition. "false" otherwise
+func (i Prime) IsAPrime() bool {
+	_, ok := _PrimeMap[i]
+	return ok
+}
+
+func (i Prime) Value() (driver.Value, error) {
+	return i.String(), nil
+}
+
+func (i *Prime) Scan(value interface{}) error {
+	if value == nil {
+		return nil
+	}
+
+	var val Prime
+	var exact bool
+	switch v := value.(type) {
+	case []byte:
+		return i.Scan(string(v))
+	case string:
+		s, err := PrimeString(v)
+		if err != nil {
+			return err
+		}
+		*i = s
+		return nil
+	case int64:
+		val = Prime(v)
+		exact = int64(val) == v
+	case int32:
+		val = Prime(v)
+		exact = int32(val) == v
+	case uint64:
+		val = Prime(v)
+		exact = int64(v) >= 0 && uint64(val) == v
+	case float64:
+		val = Prime(v)
+		exact = float64(val) == v
+	default:
+		return fmt.Errorf("cannot scan %T value %v into Prime", value, value)
+	}
+	if !exact || !val.IsAPrime() {
+		return fmt.Errorf("%T value %v is not a valid Prime", value, value)
+	}
+
+	*i = val
+	return nil
+}
+`
+
+const primeJsonAndSqlIn = `type Prime int
+const (
+	p2 Prime = 2
+	p3 Prime = 3
+	p5 Prime = 5
+	p7 Prime = 7
+	p77 Prime = 7 // Duplicate; note that p77 doesn't appear below.
+	p11 Prime = 11
+	p13 Prime = 13
+	p17 Prime = 17
+	p19 Prime = 19
+	p23 Prime = 23
+	p29 Prime = 29
+	p37 Prime = 31
+	p41 Prime = 41
+	p43 Prime = 43
+)
+`
+
+const primeJsonAndSqlOut = `
+const _PrimeName = "p2p3p5p7p11p13p17p19p23p29p37p41p43"
+
+var _PrimeMap = map[Prime]string{
+	2:  _PrimeName[0:2],
+	3:  _PrimeName[2:4],
+	5:  _PrimeName[4:6],
+	7:  _PrimeName[6:8],
+	11: _PrimeName[8:11],
+	13: _PrimeName[11:14],
+	17: _PrimeName[14:17],
+	19: _PrimeName[17:20],
+	23: _PrimeName[20:23],
+	29: _PrimeName[23:26],
+	31: _PrimeName[26:29],
+	41: _PrimeName[29:32],
+	43: _PrimeName[32:35],
+}
+
+func (i Prime) String() string {
+	if str, ok := _PrimeMap[i]; ok {
+		return str
+	}
+	return fmt.Sprintf("Prime(%d)", i)
+}
+
+var _PrimeValues = []Prime{2, 3, 5, 7, 11, 13, 17, 19, 23, 29, 31, 41, 43}
+
+var _PrimeNameToValueMap = map[string]Prime{
+	_PrimeName[0:2]:   2,
+	_PrimeName[2:4]:   3,
+	_PrimeName[4:6]:   5,
+	_PrimeName[6:8]:   7,
+	_PrimeName[8:11]:  11,
+	_PrimeName[11:14]: 13,
+	_PrimeName[14:17]: 17,
+	_PrimeName[17:20]: 19,
+	_PrimeName[20:23]: 23,
+	_PrimeName[23:26]: 29,
+	_PrimeName[26:29]: 31,
+	_PrimeName[29:32]: 41,
+	_PrimeName[32:35]: 43,
+}
+
+// PrimeString retrieves an enum value from the enum constants string name.
+// Throws an error if the param is not part of the enum.
+func PrimeString(s string) (Prime, error) {
+	if val, ok := _PrimeNameToValueMap[s]; ok {
+		return val, nil
+	}
+	return 0, fmt.Errorf("%s does not belong to Prime values", s)
+}
+
+// PrimeValues returns all values of the enum
+func PrimeValues() []Prime {
+	return _PrimeValues
+}
+
+// IsAPrime returns "true" if the value is listed in the enum definition. "false" otherwise
+func (i Prime) IsAPrime() bool {
+	_, ok := _PrimeMap[i]
+	return ok
+}
+
+// MarshalJSON implements the json.Marshaler interface for Prime
+func (i Prime) MarshalJSON() ([]byte, error) {
+	return json.Marshal(i.String())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface for Prime
+func (i *Prime) UnmarshalJSON(data []byte) error {
+	var s string
+	var err error
+	if err = json.Unmarshal(data, &s); err != nil {
+		return fmt.Errorf("Prime should be a string, got %s", data)
+	}
+
+	*i, err = PrimeString(s)
+	return err
+}
+
+func (i Prime) Value() (driver.Value, error) {
+	return i.String(), nil
+}
+
+func (i *Prime) Scan(value interface{}) error {
+	if value == nil {
+		return nil
+	}
+
+	var val Prime
+	var exact bool
+	switch v := value.(type) {
+	case []byte:
+		return i.Scan(string(v))
+	case string:
+		s, err := PrimeString(v)
+		if err != nil {
+			return err
+		}
+		*i = s
+		return nil
+	case int64:
+		val = Prime(v)
+		exact = int64(val) == v
+	case int32:
+		val = Prime(v)
+		exact = int32(val) == v
+	case uint64:
+		val = Prime(v)
+		exact = int64(v) >= 0 && uint64(val) == v
+	case float64:
+		val = Prime(v)
+		exact = float64(val) == v
+	default:
+		return fmt.Errorf("cannot scan %T value %v into Prime", value, value)
+	}
+	if !exact || !val.IsAPrime() {
+		return fmt.Errorf("%T value %v is not a valid Prime", value, value)
+	}
+
+	*i = val
+	return nil
+}
+`
+
+const prefixIn = `type Day int
+const (
+	DayMonday Day = iota
+	DayTuesday
+	DayWednesday
+	DayThursday
+	DayFriday
+	DaySaturday
+	DaySunday
+)
+`
+
+const primeWithLineCommentIn = `type Prime int
+const (
+	p2 Prime = 2
+	p3 Prime = 3
+	p5 Prime = 5 // GoodPrime
+	p7 Prime = 7
+	p77 Prime = 7 // Duplicate; note that p77 doesn't appear below.
+	p11 Prime = 11
+	p13 Prime = 13
+	p17 Prime = 17
+	p19 Prime = 19
+	p23 Prime = 23
+	p29 Prime = 29
+	p37 Prime = 31
+	p41 Prime = 41 // TwinPrime41
+	p43 Prime = 43 // Twin prime 43
+)
+`
+
+const primeWithLineCommentOut = `
+const _PrimeName = "p2p3GoodPrimep7p11p13p17p19p23p29p37TwinPrime41Twin prime 43"
+
+var _PrimeMap = map[Prime]string{
+	2:  _PrimeName[0:2],
+	3:  _PrimeName[2:4],
+	5:  _PrimeName[4:13],
+	7:  _PrimeName[13:15],
+	11: _PrimeName[15:18],
+	13: _PrimeName[18:21],
+	17: _PrimeName[21:24],
+	19: _PrimeName[24:27],
+	23: _PrimeName[27:30],
+	29: _PrimeName[30:33],
+	31: _PrimeName[33:36],
+	41: _PrimeName[36:47],
+	43: _PrimeName[47:60],
+}
+
+func (i Prime) String() string {
+	if str, ok := _PrimeMap[i]; ok {
+		return str
+	}
+	return fmt.Sprintf("Prime(%d)", i)
+}
+
+var _PrimeValues = []Prime{2, 3, 5, 7, 11, 13, 17, 19, 23, 29, 31, 41, 43}
+
+var _PrimeNameToValueMap = map[string]Prime{
+	_PrimeName[0:2]:   2,
+	_PrimeName[2:4]:   3,
+	_PrimeName[4:13]:  5,
+	_PrimeName[13:15]: 7,
+	_PrimeName[15:18]: 11,
+	_PrimeName[18:21]: 13,
+	_PrimeName[21:24]: 17,
+	_PrimeName[24:27]: 19,
+	_PrimeName[27:30]: 23,
+	_PrimeName[30:33]: 29,
+	_PrimeName[33:36]: 31,
+	_PrimeName[36:47]: 41,
+	_PrimeName[47:60]: 43,
+}
+
+// PrimeString retrieves an enum value from the enum constants string name.
+// Throws an error if the param is not part of the enum.
+func PrimeString(s string) (Prime, error) {
+	if val, ok := _PrimeNameToValueMap[s]; ok {
+		return val, nil
+	}
+	return 0, fmt.Errorf("%s does not belong to Prime values", s)
+}
+
+// PrimeValues returns all values of the enum
+func PrimeValues() []Prime {
+	return _PrimeValues
+}
+
+// IsAPrime returns "true" if the value is listed in the enum definition. "false" otherwise
+func (i Prime) IsAPrime() bool {
+	_, ok := _PrimeMap[i]
+	return ok
+}
+`
+
+const dayBinaryOut = `
+// MarshalBinary implements the encoding.BinaryMarshaler interface for Day
+func (i Day) MarshalBinary() ([]byte, error) {
+	return binary.AppendVarint(nil, int64(i)), nil
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface for Day
+func (i *Day) UnmarshalBinary(data []byte) error {
+	v, n := binary.Varint(data)
+	if n <= 0 || n != len(data) {
+		return fmt.Errorf("Day should be a varint, got %x", data)
+	}
+	val := Day(v)
+	if int64(val) != v || !val.IsADay() {
+		return fmt.Errorf("Invalid value for Day (%d)", v)
+	}
+	*i = val
+	return nil
+}
+`
+
+const dayBinaryNameOut = `
+// MarshalBinary implements the encoding.BinaryMarshaler interface for Day
+func (i Day) MarshalBinary() ([]byte, error) {
+	return []byte(i.String()), nil
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface for Day
+func (i *Day) UnmarshalBinary(data []byte) error {
+	val, err := DayString(string(data))
+	if err != nil {
+		return err
+	}
+	*i = val
+	return nil
+}
+`
+
+const unumBinaryOut = `
+// MarshalBinary implements the encoding.BinaryMarshaler interface for Unum
+func (i Unum) MarshalBinary() ([]byte, error) {
+	return binary.AppendUvarint(nil, uint64(i)), nil
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface for Unum
+func (i *Unum) UnmarshalBinary(data []byte) error {
+	v, n := binary.Uvarint(data)
+	if n <= 0 || n != len(data) {
+		return fmt.Errorf("Unum should be a varint, got %x", data)
+	}
+	val := Unum(v)
+	if uint64(val) != v || !val.IsAUnum() {
+		return fmt.Errorf("Invalid value for Unum (%d)", v)
+	}
+	*i = val
+	return nil
+}
+`
+
+const dayXMLOut = `
+// MarshalXML implements the xml.Marshaler interface for Day
+func (i Day) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return e.EncodeElement(i.String(), start)
+}
+
+// UnmarshalXML implements the xml.Unmarshaler interface for Day
+func (i *Day) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	var s string
+	if err := d.DecodeElement(&s, &start); err != nil {
+		return err
+	}
+
+	var err error
+	*i, err = DayString(s)
+	return err
+}
+
+// MarshalXMLAttr implements the xml.MarshalerAttr interface for Day
+func (i Day) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return xml.Attr{Name: name, Value: i.String()}, nil
+}
+
+// UnmarshalXMLAttr implements the xml.UnmarshalerAttr interface for Day
+func (i *Day) UnmarshalXMLAttr(attr xml.Attr) error {
+	var err error
+	*i, err = DayString(attr.Value)
+	return err
+}
+`
+
+const dayYAMLv3Out = `
+// MarshalYAML implements the yaml.Marshaler interface for Day
+func (i Day) MarshalYAML() (interface{}, error) {
+	return i.String(), nil
+}
+
+// UnmarshalYAML implements the yaml.Unmarshaler interface for Day
+func (i *Day) UnmarshalYAML(value *yaml.Node) error {
+	if value.Kind != yaml.ScalarNode {
+		return fmt.Errorf("%d:%d: Day should be a scalar, got %s", value.Line, value.Column, value.ShortTag())
+	}
+
+	val, err := DayString(value.Value)
+	if err != nil {
+		return fmt.Errorf("%d:%d: %w", value.Line, value.Column, err)
+	}
+	*i = val
+	return nil
+}
+`
+
+const dayGraphQLOut = `
+var _DayGraphQLNames = map[Day]string{
+	0: "MONDAY",
+	1: "TUESDAY",
+	2: "WEDNESDAY",
+	3: "THURSDAY",
+	4: "FRIDAY",
+	5: "SATURDAY",
+	6: "SUNDAY",
+}
+
+// MarshalGQL implements the graphql.Marshaler interface for Day
+func (i Day) MarshalGQL(w io.Writer) {
+	name, ok := _DayGraphQLNames[i]
+	if !ok {
+		name = i.String()
+	}
+	io.WriteString(w, strconv.Quote(name))
+}
+
+// UnmarshalGQL implements the graphql.Unmarshaler interface for Day
+func (i *Day) UnmarshalGQL(v interface{}) error {
+	s, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("Day should be a string, got %T", v)
+	}
+	for val, name := range _DayGraphQLNames {
+		if name == s {
+			*i = val
+			return nil
+		}
+	}
+	return fmt.Errorf("%s does not belong to Day GraphQL values", s)
+}
+`
+
+const daySqlIntOut = `
+func (i Day) Value() (driver.Value, error) {
+	return int64(i), nil
+}
+
+func (i *Day) Scan(value interface{}) error {
+	if value == nil {
+		return nil
+	}
+
+	var val Day
+	var exact bool
+	switch v := value.(type) {
+	case []byte:
+		return i.Scan(string(v))
+	case string:
+		s, err := DayString(v)
+		if err != nil {
+			if n, perr := strconv.ParseInt(v, 10, 64); perr == nil {
+				return i.Scan(n)
+			}
+			return err
+		}
+		*i = s
+		return nil
+	case int64:
+		val = Day(v)
+		exact = int64(val) == v
+	case int32:
+		val = Day(v)
+		exact = int32(val) == v
+	case uint64:
+		val = Day(v)
+		exact = int64(v) >= 0 && uint64(val) == v
+	case float64:
+		val = Day(v)
+		exact = float64(val) == v
+	default:
+		return fmt.Errorf("cannot scan %T value %v into Day", value, value)
+	}
+	if !exact || !val.IsADay() {
+		return fmt.Errorf("%T value %v is not a valid Day", value, value)
+	}
+
+	*i = val
+	return nil
+}
+`
+
+const dayNullSqlOut = `
+// NullDay represents a Day that may be null, in the style of sql.NullString.
+// Null is distinct from the zero value: it is encoded as SQL NULL, JSON null,
+// empty text, YAML null, no XML element or attribute, empty binary data or
+// GraphQL null, depending on the methods that are generated.
+type NullDay struct {
+	Day
+	Valid bool // Valid is true if Day is not null
+}
+
+// Value implements the driver.Valuer interface for NullDay
+func (n NullDay) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+	return n.Day.Value()
+}
+
+// Scan implements the sql.Scanner interface for NullDay
+func (n *NullDay) Scan(value interface{}) error {
+	if value == nil {
+		n.Day, n.Valid = 0, false
+		return nil
+	}
+	var val Day
+	if err := val.Scan(value); err != nil {
+		return err
+	}
+	n.Day, n.Valid = val, true
+	return nil
+}
+`
+
+const dayYAMLv3NumericOut = `
+const _DayName = "MondayTuesdayWednesdayThursdayFridaySaturdaySunday"
+
+var _DayIndex = [...]uint8{0, 6, 13, 22, 30, 36, 44, 50}
+
+func (i Day) String() string {
+	if i < 0 || i >= Day(len(_DayIndex)-1) {
+		return fmt.Sprintf("Day(%d)", i)
+	}
+	return _DayName[_DayIndex[i]:_DayIndex[i+1]]
+}
+
+var _DayValues = []Day{0, 1, 2, 3, 4, 5, 6}
+
+var _DayNameToValueMap = map[string]Day{
+	_DayName[0:6]:   0,
+	_DayName[6:13]:  1,
+	_DayName[13:22]: 2,
+	_DayName[22:30]: 3,
+	_DayName[30:36]: 4,
+	_DayName[36:44]: 5,
+	_DayName[44:50]: 6,
+}
+
+// DayString retrieves an enum value from the enum constants string name.
+// Throws an error if the param is not part of the enum.
+func DayString(s string) (Day, error) {
+	if val, ok := _DayNameToValueMap[s]; ok {
+		return val, nil
+	}
+	i, err := strconv.Atoi(s)
+	if err == nil {
+		for _, v := range _DayNameToValueMap {
+			if int(v) == i {
+				return v, nil
+			}
+		}
+	}
+	return 0, fmt.Errorf("%s does not belong to Day values", s)
+}
+
+// DayValues returns all values of the enum
+func DayValues() []Day {
+	return _DayValues
+}
+
+// IsADay returns "true" if the value is listed in the enum definition. "false" otherwise
+func (i Day) IsADay() bool {
+	for _, v := range _DayValues {
+		if i == v {
+			return true
+		}
+	}
+	return false
+}
+
+// MarshalYAML implements the yaml.Marshaler interface for Day
+func (i Day) MarshalYAML() (interface{}, error) {
+	return i.String(), nil
+}
+
+// UnmarshalYAML implements the yaml.Unmarshaler interface for Day
+func (i *Day) UnmarshalYAML(value *yaml.Node) error {
+	if value.Kind != yaml.ScalarNode {
+		return fmt.Errorf("%d:%d: Day should be a scalar, got %s", value.Line, value.Column, value.ShortTag())
+	}
+	if value.ShortTag() == "!!int" {
+		var n int64
+		if err := value.Decode(&n); err != nil {
+			return fmt.Errorf("%d:%d: %w", value.Line, value.Column, err)
+		}
+		val := Day(n)
+		if int64(val) != n || !val.IsADay() {
+			return fmt.Errorf("%d:%d: Invalid value for Day (%d)", value.Line, value.Column, n)
+		}
+		*i = val
+		return nil
+	}
+
+	val, err := DayString(value.Value)
+	if err != nil {
+		return fmt.Errorf("%d:%d: %w", value.Line, value.Column, err)
+	}
+	*i = val
+	return nil
+}
+`
+
+const dayNullDecodersOut = `
+const _DayName = "MondayTuesdayWednesdayThursdayFridaySaturdaySunday"
+
+var _DayIndex = [...]uint8{0, 6, 13, 22, 30, 36, 44, 50}
+
+func (i Day) String() string {
+	if i < 0 || i >= Day(len(_DayIndex)-1) {
+		return fmt.Sprintf("Day(%d)", i)
+	}
+	return _DayName[_DayIndex[i]:_DayIndex[i+1]]
+}
+
+var _DayValues = []Day{0, 1, 2, 3, 4, 5, 6}
+
+var _DayNameToValueMap = map[string]Day{
+	_DayName[0:6]:   0,
+	_DayName[6:13]:  1,
+	_DayName[13:22]: 2,
+	_DayName[22:30]: 3,
+	_DayName[30:36]: 4,
+	_DayName[36:44]: 5,
+	_DayName[44:50]: 6,
+}
+
+// DayString retrieves an enum value from the enum constants string name.
+// Throws an error if the param is not part of the enum.
+func DayString(s string) (Day, error) {
+	if val, ok := _DayNameToValueMap[s]; ok {
+		return val, nil
+	}
+	return 0, fmt.Errorf("%s does not belong to Day values", s)
+}
+
+// DayValues returns all values of the enum
+func DayValues() []Day {
+	return _DayValues
+}
+
+// IsADay returns "true" if the value is listed in the enum definition. "false" otherwise
+func (i Day) IsADay() bool {
+	for _, v := range _DayValues {
+		if i == v {
+			return true
+		}
+	}
+	return false
+}
+
+// MarshalBinary implements the encoding.BinaryMarshaler interface for Day
+func (i Day) MarshalBinary() ([]byte, error) {
+	return binary.AppendVarint(nil, int64(i)), nil
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface for Day
+func (i *Day) UnmarshalBinary(data []byte) error {
+	v, n := binary.Varint(data)
+	if n <= 0 || n != len(data) {
+		return fmt.Errorf("Day should be a varint, got %x", data)
+	}
+	val := Day(v)
+	if int64(val) != v || !val.IsADay() {
+		return fmt.Errorf("Invalid value for Day (%d)", v)
+	}
+	*i = val
+	return nil
+}
+
+var _DayGraphQLNames = map[Day]string{
+	0: "MONDAY",
+	1: "TUESDAY",
+	2: "WEDNESDAY",
+	3: "THURSDAY",
+	4: "FRIDAY",
+	5: "SATURDAY",
+	6: "SUNDAY",
+}
+
+// MarshalGQL implements the graphql.Marshaler interface for Day
+func (i Day) MarshalGQL(w io.Writer) {
+	name, ok := _DayGraphQLNames[i]
+	if !ok {
+		name = i.String()
+	}
+	io.WriteString(w, strconv.Quote(name))
+}
+
+// UnmarshalGQL implements the graphql.Unmarshaler interface for Day
+func (i *Day) UnmarshalGQL(v interface{}) error {
+	s, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("Day should be a string, got %T", v)
+	}
+	for val, name := range _DayGraphQLNames {
+		if name == s {
+			*i = val
+			return nil
+		}
+	}
+	return fmt.Errorf("%s does not belong to Day GraphQL values", s)
+}
+
+// MarshalXML implements the xml.Marshaler interface for Day
+func (i Day) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return e.EncodeElement(i.String(), start)
+}
+
+// UnmarshalXML implements the xml.Unmarshaler interface for Day
+func (i *Day) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	var s string
+	if err := d.DecodeElement(&s, &start); err != nil {
+		return err
+	}
+
+	var err error
+	*i, err = DayString(s)
+	return err
+}
+
+// MarshalXMLAttr implements the xml.MarshalerAttr interface for Day
+func (i Day) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return xml.Attr{Name: name, Value: i.String()}, nil
+}
+
+// UnmarshalXMLAttr implements the xml.UnmarshalerAttr interface for Day
+func (i *Day) UnmarshalXMLAttr(attr xml.Attr) error {
+	var err error
+	*i, err = DayString(attr.Value)
+	return err
+}
+
+// Set implements the flag.Value interface for Day
+func (i *Day) Set(s string) error {
+	val, err := DayString(s)
+	if err != nil {
+		return err
+	}
+	*i = val
+	return nil
+}
+
+// Type implements the pflag.Value interface for Day
+func (i Day) Type() string {
+	return "Day"
+}
+
+// DayUsage lists the values of the enum, for use in the usage string of a
+// Day flag: flag.Var(&v, "name", "what it is; "+DayUsage())
+func DayUsage() string {
+	names := make([]string, len(_DayValues))
+	for i, v := range _DayValues {
+		names[i] = v.String()
+	}
+	return "one of " + strings.Join(names, ", ")
+}
+
+// NullDay represents a Day that may be null, in the style of sql.NullString.
+// Null is distinct from the zero value: it is encoded as SQL NULL, JSON null,
+// empty text, YAML null, no XML element or attribute, empty binary data or
+// GraphQL null, depending on the methods that are generated.
+type NullDay struct {
+	Day
+	Valid bool // Valid is true if Day is not null
+}
+
+// MarshalXML implements the xml.Marshaler interface for NullDay.
+// Null is encoded as no element.
+func (n NullDay) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if !n.Valid {
+		return nil
+	}
+	return n.Day.MarshalXML(e, start)
+}
+
+// UnmarshalXML implements the xml.Unmarshaler interface for NullDay
+func (n *NullDay) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	var val Day
+	if err := val.UnmarshalXML(d, start); err != nil {
+		return err
+	}
+	n.Day, n.Valid = val, true
+	return nil
+}
+
+// MarshalXMLAttr implements the xml.MarshalerAttr interface for NullDay.
+// Null is encoded as no attribute.
+func (n NullDay) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	if !n.Valid {
+		return xml.Attr{}, nil
+	}
+	return n.Day.MarshalXMLAttr(name)
+}
+
+// UnmarshalXMLAttr implements the xml.UnmarshalerAttr interface for NullDay
+func (n *NullDay) UnmarshalXMLAttr(attr xml.Attr) error {
+	var val Day
+	if err := val.UnmarshalXMLAttr(attr); err != nil {
+		return err
+	}
+	n.Day, n.Valid = val, true
+	return nil
+}
+
+// MarshalBinary implements the encoding.BinaryMarshaler interface for NullDay.
+// Null is encoded as no data.
+func (n NullDay) MarshalBinary() ([]byte, error) {
+	if !n.Valid {
+		return []byte{}, nil
+	}
+	return n.Day.MarshalBinary()
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface for NullDay
+func (n *NullDay) UnmarshalBinary(data []byte) error {
+	if len(data) == 0 {
+		n.Day, n.Valid = 0, false
+		return nil
+	}
+	var val Day
+	if err := val.UnmarshalBinary(data); err != nil {
+		return err
+	}
+	n.Day, n.Valid = val, true
+	return nil
+}
+
+// MarshalGQL implements the graphql.Marshaler interface for NullDay
+func (n NullDay) MarshalGQL(w io.Writer) {
+	if !n.Valid {
+		io.WriteString(w, "null")
+		return
+	}
+	n.Day.MarshalGQL(w)
+}
+
+// UnmarshalGQL implements the graphql.Unmarshaler interface for NullDay
+func (n *NullDay) UnmarshalGQL(v interface{}) error {
+	if v == nil {
+		n.Day, n.Valid = 0, false
+		return nil
+	}
+	var val Day
+	if err := val.UnmarshalGQL(v); err != nil {
+		return err
+	}
+	n.Day, n.Valid = val, true
+	return nil
+}
+
+// Set implements the flag.Value interface for NullDay
+func (n *NullDay) Set(s string) error {
+	var val Day
+	if err := val.Set(s); err != nil {
+		return err
+	}
+	n.Day, n.Valid = val, true
+	return nil
+}
+`
+
+const dayNullYAMLv3Out = `
+// NullDay represents a Day that may be null, in the style of sql.NullString.
+// Null is distinct from the zero value: it is encoded as SQL NULL, JSON null,
+// empty text, YAML null, no XML element or attribute, empty binary data or
+// GraphQL null, depending on the methods that are generated.
+type NullDay struct {
+	Day
+	Valid bool // Valid is true if Day is not null
+}
+
+// MarshalYAML implements a YAML Marshaler for NullDay
+func (n NullDay) MarshalYAML() (interface{}, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+	return n.Day.MarshalYAML()
+}
+
+// UnmarshalYAML implements the yaml.Unmarshaler interface for NullDay.
+// yaml.v3 doesn't call it for null, and leaves the NullDay untouched:
+// decode into a zero value or into a *NullDay to read null as invalid.
+func (n *NullDay) UnmarshalYAML(value *yaml.Node) error {
+	var val Day
+	if err := val.UnmarshalYAML(value); err != nil {
+		return err
+	}
+	n.Day, n.Valid = val, true
+	return nil
+}
+`
+
+const dayNullPGArrayOut = `
+const _DayName = "MondayTuesdayWednesdayThursdayFridaySaturdaySunday"
+
+var _DayIndex = [...]uint8{0, 6, 13, 22, 30, 36, 44, 50}
+
+func (i Day) String() string {
+	if i < 0 || i >= Day(len(_DayIndex)-1) {
+		return fmt.Sprintf("Day(%d)", i)
+	}
+	return _DayName[_DayIndex[i]:_DayIndex[i+1]]
+}
+
+var _DayValues = []Day{0, 1, 2, 3, 4, 5, 6}
+
+var _DayNameToValueMap = map[string]Day{
+	_DayName[0:6]:   0,
+	_DayName[6:13]:  1,
+	_DayName[13:22]: 2,
+	_DayName[22:30]: 3,
+	_DayName[30:36]: 4,
+	_DayName[36:44]: 5,
+	_DayName[44:50]: 6,
+}
+
+// DayString retrieves an enum value from the enum constants string name.
+// Throws an error if the param is not part of the enum.
+func DayString(s string) (Day, error) {
+	if val, ok := _DayNameToValueMap[s]; ok {
+		return val, nil
+	}
+	return 0, fmt.Errorf("%s does not belong to Day values", s)
+}
+
+// DayValues returns all values of the enum
+func DayValues() []Day {
+	return _DayValues
+}
+
+// IsADay returns "true" if the value is listed in the enum definition. "false" otherwise
+func (i Day) IsADay() bool {
+	for _, v := range _DayValues {
+		if i == v {
+			return true
+		}
+	}
+	return false
+}
+
+// NullDay represents a Day that may be null, in the style of sql.NullString.
+// Null is distinct from the zero value: it is encoded as SQL NULL, JSON null,
+// empty text, YAML null, no XML element or attribute, empty binary data or
+// GraphQL null, depending on the methods that are generated.
+type NullDay struct {
+	Day
+	Valid bool // Valid is true if Day is not null
+}
+
+// DaySlice is a list of Day values stored in a PostgreSQL array column,
+// such as text[] or an array of a native enum type.
+type DaySlice []Day
+
+// Value implements the driver.Valuer interface for DaySlice. Every element
+// is quoted, so any string representation makes a valid array literal.
+func (s DaySlice) Value() (driver.Value, error) {
+	if s == nil {
+		return nil, nil
+	}
+	var b strings.Builder
+	b.WriteByte('{')
+	for n, v := range s {
+		if !v.IsADay() {
+			return nil, fmt.Errorf("DaySlice: element %d: invalid value %d", n, v)
+		}
+		if n > 0 {
+			b.WriteByte(',')
+		}
+		_DayQuoteArrayElem(&b, v.String())
+	}
+	b.WriteByte('}')
+	return b.String(), nil
+}
+
+// Scan implements the sql.Scanner interface for DaySlice. It parses a
+// one-dimensional PostgreSQL array literal, such as {a,b,"c d"}. NULL
+// elements can't be represented and are reported as errors; see
+// NullDaySlice, generated with -null, for arrays that hold them.
+func (s *DaySlice) Scan(value interface{}) error {
+	elems, err := _DayArrayElems(value)
+	if err != nil {
+		return err
+	}
+	if elems == nil {
+		*s = nil
+		return nil
+	}
+	list := make(DaySlice, 0, len(elems))
+	for n, elem := range elems {
+		if elem == nil {
+			return fmt.Errorf("DaySlice: element %d is NULL", n)
+		}
+		val, err := DayString(*elem)
+		if err != nil {
+			return fmt.Errorf("DaySlice: element %d: %w", n, err)
+		}
+		list = append(list, val)
+	}
+	*s = list
+	return nil
+}
+
+// _DayQuoteArrayElem writes s to b as a quoted element of a PostgreSQL array literal.
+func _DayQuoteArrayElem(b *strings.Builder, s string) {
+	b.WriteByte('"')
+	for _, r := range s {
+		if r == '"' || r == '\\' {
+			b.WriteByte('\\')
+		}
+		b.WriteRune(r)
+	}
+	b.WriteByte('"')
+}
+
+// _DayArrayElems splits a one-dimensional PostgreSQL array literal, such as
+// {a,NULL,"c d"}, into its elements, with nil for the NULL ones. It returns a nil
+// slice for a NULL array.
+func _DayArrayElems(value interface{}) ([]*string, error) {
+	var str string
+	switch v := value.(type) {
+	case nil:
+		return nil, nil
+	case []byte:
+		str = string(v)
+	case string:
+		str = v
+	default:
+		return nil, fmt.Errorf("cannot scan %T value %v into a Day array", value, value)
+	}
+	if len(str) < 2 || str[0] != '{' || str[len(str)-1] != '}' {
+		return nil, fmt.Errorf("malformed Day array literal %q", str)
+	}
+
+	const space = " \t\n\v\f\r"
+	elems := strings.Trim(str[1:len(str)-1], space)
+	list := []*string{}
+	for n := 0; elems != ""; n++ {
+		if n > 0 {
+			if elems[0] != ',' {
+				return nil, fmt.Errorf("malformed Day array literal %q", str)
+			}
+			elems = strings.TrimLeft(elems[1:], space)
+			if elems == "" {
+				return nil, fmt.Errorf("malformed Day array literal %q", str)
+			}
+		}
+		if elems[0] == '"' {
+			var b strings.Builder
+			i := 1
+			for ; i < len(elems) && elems[i] != '"'; i++ {
+				if elems[i] == '\\' && i+1 < len(elems) {
+					i++
+				}
+				b.WriteByte(elems[i])
+			}
+			if i == len(elems) {
+				return nil, fmt.Errorf("malformed Day array literal %q", str)
+			}
+			elem := b.String()
+			list = append(list, &elem)
+			elems = strings.TrimLeft(elems[i+1:], space)
+			continue
+		}
+		// An unquoted element ends at the next comma. Backslashes escape
+		// the byte that follows, which keeps it even if it is a comma or
+		// trailing whitespace, and an escaped NULL is the string NULL.
+		var b strings.Builder
+		escaped := false
+		end := 0 // Length of b up to its last escaped or non-space byte
+		i := 0
+		for ; i < len(elems) && elems[i] != ','; i++ {
+			switch c := elems[i]; {
+			case c == '\\':
+				if i+1 == len(elems) {
+					return nil, fmt.Errorf("malformed Day array literal %q", str)
+				}
+				i++
+				b.WriteByte(elems[i])
+				escaped, end = true, b.Len()
+			case c == '"' || c == '{' || c == '}':
+				return nil, fmt.Errorf("malformed Day array literal %q", str)
+			default:
+				b.WriteByte(c)
+				if strings.IndexByte(space, c) < 0 {
+					end = b.Len()
+				}
+			}
+		}
+		elem := b.String()[:end]
+		elems = elems[i:]
+		switch {
+		case elem == "" && !escaped:
+			return nil, fmt.Errorf("malformed Day array literal %q", str)
+		case !escaped && strings.EqualFold(elem, "NULL"):
+			list = append(list, nil)
+		default:
+			list = append(list, &elem)
+		}
+	}
+	return list, nil
+}
+
+// NullDaySlice is a list of NullDay values stored in a PostgreSQL array
+// column. Unlike DaySlice, it holds NULL elements, as invalid NullDays.
+type NullDaySlice []NullDay
+
+// Value implements the driver.Valuer interface for NullDaySlice
+func (s NullDaySlice) Value() (driver.Value, error) {
+	if s == nil {
+		return nil, nil
+	}
+	var b strings.Builder
+	b.WriteByte('{')
+	for n, v := range s {
+		if n > 0 {
+			b.WriteByte(',')
+		}
+		if !v.Valid {
+			b.WriteString("NULL")
+			continue
+		}
+		if !v.IsADay() {
+			return nil, fmt.Errorf("NullDaySlice: element %d: invalid value %d", n, v.Day)
+		}
+		_DayQuoteArrayElem(&b, v.String())
+	}
+	b.WriteByte('}')
+	return b.String(), nil
+}
+
+// Scan implements the sql.Scanner interface for NullDaySlice. It parses a
+// one-dimensional PostgreSQL array literal, such as {a,NULL,"c d"}.
+func (s *NullDaySlice) Scan(value interface{}) error {
+	elems, err := _DayArrayElems(value)
+	if err != nil {
+		return err
+	}
+	if elems == nil {
+		*s = nil
+		return nil
+	}
+	list := make(NullDaySlice, len(elems))
+	for n, elem := range elems {
+		if elem == nil {
+			continue
+		}
+		val, err := DayString(*elem)
+		if err != nil {
+			return fmt.Errorf("NullDaySlice: element %d: %w", n, err)
+		}
+		list[n] = NullDay{Day: val, Valid: true}
+	}
+	*s = list
+	return nil
+}
+`
+
+const dayPGArrayOut = `
+// DaySlice is a list of Day values stored in a PostgreSQL array column,
+// such as text[] or an array of a native enum type.
+type DaySlice []Day
+
+// Value implements the driver.Valuer interface for DaySlice. Every element
+// is quoted, so any string representation makes a valid array literal.
+func (s DaySlice) Value() (driver.Value, error) {
+	if s == nil {
+		return nil, nil
+	}
+	var b strings.Builder
+	b.WriteByte('{')
+	for n, v := range s {
+		if !v.IsADay() {
+			return nil, fmt.Errorf("DaySlice: element %d: invalid value %d", n, v)
+		}
+		if n > 0 {
+			b.WriteByte(',')
+		}
+		_DayQuoteArrayElem(&b, v.String())
+	}
+	b.WriteByte('}')
+	return b.String(), nil
+}
+
+// Scan implements the sql.Scanner interface for DaySlice. It parses a
+// one-dimensional PostgreSQL array literal, such as {a,b,"c d"}. NULL
+// elements can't be represented and are reported as errors; see
+// NullDaySlice, generated with -null, for arrays that hold them.
+func (s *DaySlice) Scan(value interface{}) error {
+	elems, err := _DayArrayElems(value)
+	if err != nil {
+		return err
+	}
+	if elems == nil {
+		*s = nil
+		return nil
+	}
+	list := make(DaySlice, 0, len(elems))
+	for n, elem := range elems {
+		if elem == nil {
+			return fmt.Errorf("DaySlice: element %d is NULL", n)
+		}
+		val, err := DayString(*elem)
+		if err != nil {
+			return fmt.Errorf("DaySlice: element %d: %w", n, err)
+		}
+		list = append(list, val)
+	}
+	*s = list
+	return nil
+}
+
+// _DayQuoteArrayElem writes s to b as a quoted element of a PostgreSQL array literal.
+func _DayQuoteArrayElem(b *strings.Builder, s string) {
+	b.WriteByte('"')
+	for _, r := range s {
+		if r == '"' || r == '\\' {
+			b.WriteByte('\\')
+		}
+		b.WriteRune(r)
+	}
+	b.WriteByte('"')
+}
+
+// _DayArrayElems splits a one-dimensional PostgreSQL array literal, such as
+// {a,NULL,"c d"}, into its elements, with nil for the NULL ones. It returns a nil
+// slice for a NULL array.
+func _DayArrayElems(value interface{}) ([]*string, error) {
+	var str string
+	switch v := value.(type) {
+	case nil:
+		return nil, nil
+	case []byte:
+		str = string(v)
+	case string:
+		str = v
+	default:
+		return nil, fmt.Errorf("cannot scan %T value %v into a Day array", value, value)
+	}
+	if len(str) < 2 || str[0] != '{' || str[len(str)-1] != '}' {
+		return nil, fmt.Errorf("malformed Day array literal %q", str)
+	}
+
+	const space = " \t\n\v\f\r"
+	elems := strings.Trim(str[1:len(str)-1], space)
+	list := []*string{}
+	for n := 0; elems != ""; n++ {
+		if n > 0 {
+			if elems[0] != ',' {
+				return nil, fmt.Errorf("malformed Day array literal %q", str)
+			}
+			elems = strings.TrimLeft(elems[1:], space)
+			if elems == "" {
+				return nil, fmt.Errorf("malformed Day array literal %q", str)
+			}
+		}
+		if elems[0] == '"' {
+			var b strings.Builder
+			i := 1
+			for ; i < len(elems) && elems[i] != '"'; i++ {
+				if elems[i] == '\\' && i+1 < len(elems) {
+					i++
+				}
+				b.WriteByte(elems[i])
+			}
+			if i == len(elems) {
+				return nil, fmt.Errorf("malformed Day array literal %q", str)
+			}
+			elem := b.String()
+			list = append(list, &elem)
+			elems = strings.TrimLeft(elems[i+1:], space)
+			continue
+		}
+		// An unquoted element ends at the next comma. Backslashes escape
+		// the byte that follows, which keeps it even if it is a comma or
+		// trailing whitespace, and an escaped NULL is the string NULL.
+		var b strings.Builder
+		escaped := false
+		end := 0 // Length of b up to its last escaped or non-space byte
+		i := 0
+		for ; i < len(elems) && elems[i] != ','; i++ {
+			switch c := elems[i]; {
+			case c == '\\':
+				if i+1 == len(elems) {
+					return nil, fmt.Errorf("malformed Day array literal %q", str)
+				}
+				i++
+				b.WriteByte(elems[i])
+				escaped, end = true, b.Len()
+			case c == '"' || c == '{' || c == '}':
+				return nil, fmt.Errorf("malformed Day array literal %q", str)
+			default:
+				b.WriteByte(c)
+				if strings.IndexByte(space, c) < 0 {
+					end = b.Len()
+				}
+			}
+		}
+		elem := b.String()[:end]
+		elems = elems[i:]
+		switch {
+		case elem == "" && !escaped:
+			return nil, fmt.Errorf("malformed Day array literal %q", str)
+		case !escaped && strings.EqualFold(elem, "NULL"):
+			list = append(list, nil)
+		default:
+			list = append(list, &elem)
+		}
+	}
+	return list, nil
+}
+`
+
+const dayPostgresDDL = `CREATE TYPE day AS ENUM ('Monday', 'Tuesday', 'Wednesday', 'Thursday', 'Friday', 'Saturday', 'Sunday');
+`
+
+const camelMySQLDDL = `-- Column type for Camel
+ENUM('enum-first', 'enum-second', 'enum-third', 'enum-fourth', 'enum-fifth', 'enum-sixth', 'enum-seventh')
+`
+
+const camelSQLiteDDL = `-- Column constraint for Camel
+CHECK (camel IN ('First', 'Second', 'Third', 'Fourth', 'Fifth', 'Sixth', 'Seventh'))
+`
+
+// The previous DDL lacks Monday and Thursday and declares a value that no
+// longer exists.
+const dayPreviousDDL = `CREATE TYPE day AS ENUM ('Tuesday', 'Wednesday', 'Holiday', 'Friday', 'Saturday', 'Sunday');
+`
+
+const dayAlterDDL = `ALTER TYPE day ADD VALUE 'Monday' BEFORE 'Tuesday';
+ALTER TYPE day ADD VALUE 'Thursday' AFTER 'Wednesday';
+-- 'Holiday' was removed, but it can't be dropped from day
+`
+
+const daySqlAndORMOut = `
+func (i Day) Value() (driver.Value, error) {
+	return i.String(), nil
+}
+
+func (i *Day) Scan(value interface{}) error {
+	if value == nil {
+		return nil
+	}
+
+	var val Day
+	var exact bool
+	switch v := value.(type) {
+	case []byte:
+		return i.Scan(string(v))
+	case string:
+		s, err := DayString(v)
+		if err != nil {
+			return err
+		}
+		*i = s
+		return nil
+	case int64:
+		val = Day(v)
+		exact = int64(val) == v
+	case int32:
+		val = Day(v)
+		exact = int32(val) == v
+	case uint64:
+		val = Day(v)
+		exact = int64(v) >= 0 && uint64(val) == v
+	case float64:
+		val = Day(v)
+		exact = float64(val) == v
+	default:
+		return fmt.Errorf("cannot scan %T value %v into Day", value, value)
+	}
+	if !exact || !val.IsADay() {
+		return fmt.Errorf("%T value %v is not a valid Day", value, value)
+	}
+
+	*i = val
+	return nil
+}
+
+// GormDataType implements the gorm schema.GormDataTypeInterface for Day
+func (Day) GormDataType() string {
+	return "string"
+}
+
+// GormDBDataType implements the gorm migrator.GormDBDataTypeInterface for Day.
+// The PostgreSQL column type is the enum type declared by enumer -ddl=postgres.
+func (Day) GormDBDataType(db *gorm.DB, field *schema.Field) string {
+	switch db.Dialector.Name() {
+	case "postgres":
+		return "day"
+	case "mysql":
+		return "ENUM('Monday', 'Tuesday', 'Wednesday', 'Thursday', 'Friday', 'Saturday', 'Sunday')"
+	case "sqlite":
+		return fmt.Sprintf("text CHECK (%s IN (%s))", field.DBName, "'Monday', 'Tuesday', 'Wednesday', 'Thursday', 'Friday', 'Saturday', 'Sunday'")
+	}
+	return ""
+}
+
+// Values returns the string representations of all values of the enum. It
+// implements the ent EnumValues interface for Day
+func (Day) Values() []string {
+	values := make([]string, len(_DayValues))
+	for i, v := range _DayValues {
+		values[i] = v.String()
+	}
+	return values
+}
+`
+
+const dayGormIntOut = `
+// GormDataType implements the gorm schema.GormDataTypeInterface for Day
+func (Day) GormDataType() string {
+	return "int"
+}
+`
+
+const dayFlagOut = `
+// Set implements the flag.Value interface for Day
+func (i *Day) Set(s string) error {
+	val, err := DayString(s)
+	if err != nil {
+		return err
+	}
+	*i = val
+	return nil
+}
+
+// Type implements the pflag.Value interface for Day
+func (i Day) Type() string {
+	return "Day"
+}
+
+// DayUsage lists the values of the enum, for use in the usage string of a
+// Day flag: flag.Var(&v, "name", "what it is; "+DayUsage())
+func DayUsage() string {
+	names := make([]string, len(_DayValues))
+	for i, v := range _DayValues {
+		names[i] = v.String()
+	}
+	return "one of " + strings.Join(names, ", ")
+}
+`
+
+const dayListOut = `
+// DayList is a list of Day values that is written as a single string,
+// with the values separated by "|". It is meant for flags and configuration.
+type DayList []Day
+
+// String implements the fmt.Stringer interface for DayList
+func (l DayList) String() string {
+	names := make([]string, len(l))
+	for i, v := range l {
+		names[i] = v.String()
+	}
+	return strings.Join(names, "|")
+}
+
+// Set implements the flag.Value interface for DayList. It replaces the list
+// with the values in s.
+func (l *DayList) Set(s string) error {
+	var elems []string
+	if strings.TrimSpace(s) != "" {
+		elems = strings.Split(s, "|")
+	}
+	list, err := _DayListParse(elems)
+	if err != nil {
+		return err
+	}
+	*l = list
+	return nil
+}
+
+// Type implements the pflag.Value interface for DayList
+func (l DayList) Type() string {
+	return "DayList"
+}
+
+// MarshalText implements the encoding.TextMarshaler interface for DayList
+func (l DayList) MarshalText() ([]byte, error) {
+	return []byte(l.String()), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface for DayList
+func (l *DayList) UnmarshalText(text []byte) error {
+	return l.Set(string(text))
+}
+
+// MarshalJSON implements the json.Marshaler interface for DayList. The list
+// is written as an array of strings.
+func (l DayList) MarshalJSON() ([]byte, error) {
+	names := make([]string, len(l))
+	for i, v := range l {
+		names[i] = v.String()
+	}
+	return json.Marshal(names)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface for DayList. The
+// list can be either an array of strings or a single separated string.
+func (l *DayList) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err == nil {
+		return l.Set(s)
+	}
+	var elems []string
+	if err := json.Unmarshal(data, &elems); err != nil {
+		return fmt.Errorf("DayList should be a string or an array of strings, got %s", data)
+	}
+	list, err := _DayListParse(elems)
+	if err != nil {
+		return err
+	}
+	*l = list
+	return nil
+}
+
+func _DayListParse(elems []string) (DayList, error) {
+	var list DayList
+	for n, elem := range elems {
+		val, err := DayString(strings.TrimSpace(elem))
+		if err != nil {
+			return nil, fmt.Errorf("DayList: element %d: %w", n, err)
+		}
+		duplicate := false
+		for _, v := range list {
+			if v == val {
+				duplicate = true
+				break
+			}
+		}
+		if duplicate {
+			continue
+		}
+		list = append(list, val)
+	}
+	return list, nil
+}
+`
+
+const dayCompletionsOut = `
+// DayCompletions returns the values of the enum as shell completions, in the
+// "value\tdescription" format of the cobra ValidArgsFunction
+func DayCompletions() []string {
+	return []string{
+		"Monday",
+		"Tuesday",
+		"Wednesday",
+		"Thursday",
+		"Friday",
+		"Saturday",
+		"Sunday",
+	}
+}
+`
+
+// Line comments describe the values.
+const levelIn = `type Level int
+const (
+	Info Level = iota // Informational: "it's fine"
+	Warn
+	Error // Errors only
+)
+`
+
+const levelBash = `# Completes the values of Level.
+_level_values() {
+    local cur="${COMP_WORDS[COMP_CWORD]}" v
+    local values=('Info' 'Warn' 'Error')
+    COMPREPLY=()
+    for v in "${values[@]}"; do
+        [[ $v == "$cur"* ]] && COMPREPLY+=("$v")
+    done
+}
+`
+
+const levelZsh = `# Completes the values of Level.
+_level_values() {
+  local -a values
+  values=(
+    'Info:Informational: "it'\''s fine"'
+    'Warn'
+    'Error:Errors only'
+  )
+  _describe 'Level' values
+}
+`
+
+const levelFish = `# Prints the values of Level, for complete -a '(__level_values)'.
+function __level_values
+    printf '%s\t%s\n' 'Info' 'Informational: "it\'s fine"'
+    printf '%s\n' 'Warn'
+    printf '%s\t%s\n' 'Error' 'Errors only'
+end
+`
+
+const daySlogOut = `
+// LogValue implements the slog.LogValuer interface for Day. Values that don't
+// belong to the enum are logged as a group with invalid=true.
+func (i Day) LogValue() slog.Value {
+	if !i.IsADay() {
+		return slog.GroupValue(slog.Int64("value", int64(i)), slog.Bool("invalid", true))
+	}
+	return slog.StringValue(i.String())
+}
+`
+
+const daySlogGroupOut = `
+// The groups are built once, so that logging a value doesn't allocate.
+var _DayLogValues = func() map[Day]slog.Value {
+	m := make(map[Day]slog.Value, len(_DayValues))
+	for _, v := range _DayValues {
+		m[v] = slog.GroupValue(slog.String("name", v.String()), slog.Int64("value", int64(v)))
+	}
+	return m
+}()
+
+// LogValue implements the slog.LogValuer interface for Day. Values are logged
+// as a group with their name and number; values that don't belong to the enum
+// have no name and invalid=true instead.
+func (i Day) LogValue() slog.Value {
+	if v, ok := _DayLogValues[i]; ok {
+		return v
+	}
+	return slog.GroupValue(slog.Int64("value", int64(i)), slog.Bool("invalid", true))
+}
+`
+
+const dayFormatOut = `
+var _DayGoNames = map[Day]string{
+	0: "Monday",
+	1: "Tuesday",
+	2: "Wednesday",
+	3: "Thursday",
+	4: "Friday",
+	5: "Saturday",
+	6: "Sunday",
+}
+
+// Format implements the fmt.Formatter interface for Day: %v and %s print the
+// name, %q the quoted name, %+v the name followed by the number in parentheses,
+// %#v the Go identifier and every other verb, such as %d or %x, the number.
+func (i Day) Format(f fmt.State, verb rune) {
+	switch verb {
+	case 'v':
+		switch {
+		case f.Flag('#'):
+			fmt.Fprint(f, i.GoString())
+		case f.Flag('+') && i.IsADay():
+			fmt.Fprintf(f, "%s(%d)", i.String(), int64(i))
+		default:
+			fmt.Fprintf(f, fmt.FormatString(f, 's'), i.String())
+		}
+	case 's', 'q':
+		fmt.Fprintf(f, fmt.FormatString(f, verb), i.String())
+	default:
+		fmt.Fprintf(f, fmt.FormatString(f, verb), int64(i))
+	}
+}
+
+// GoString implements the fmt.GoStringer interface for Day. It returns the
+// Go identifier of the value, such as test.Monday
+func (i Day) GoString() string {
+	if name, ok := _DayGoNames[i]; ok {
+		return "test." + name
+	}
+	return fmt.Sprintf("test.Day(%d)", int64(i))
+}
+`
+
+const dayIterOut = `
+// DayAll returns an iterator over all values of the enum, sorted by value
+func DayAll() iter.Seq[Day] {
+	return func(yield func(Day) bool) {
+		for _, v := range _DayValues {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// DayNames returns an iterator over the string representations of all
+// values of the enum, sorted by value
+func DayNames() iter.Seq[string] {
+	return func(yield func(string) bool) {
+		for _, v := range _DayValues {
+			if !yield(v.String()) {
+				return
+			}
+		}
+	}
+}
+
+// DayPairs returns an iterator over the string representations and the
+// values of the enum, sorted by value
+func DayPairs() iter.Seq2[string, Day] {
+	return func(yield func(string, Day) bool) {
+		for _, v := range _DayValues {
+			if !yield(v.String(), v) {
+				return
+			}
+		}
+	}
+}
+`
+
+const dayValues = `// DayValues returns all values of the enum
+func DayValues() []Day {
+	return _DayValues
+}
+`
+
+const dayValuesCopy = `// DayValues returns all values of the enum, in a new slice the caller may modify
+func DayValues() []Day {
+	values := make([]Day, len(_DayValues))
+	copy(values, _DayValues)
+	return values
+}
+`
+
+const dayEnumOut = `
+// IsValid implements the enum.Enum interface for Day
+func (i Day) IsValid() bool {
+	return i.IsADay()
+}
+
+// _DayDescriptor implements the enum.Descriptor interface for Day
+type _DayDescriptor struct{}
+
+// Parse retrieves an enum value from the enum constants string name
+func (_DayDescriptor) Parse(s string) (Day, error) {
+	return DayString(s)
+}
+
+// Values returns all values of the enum
+func (_DayDescriptor) Values() []Day {
+	return DayValues()
+}
+
+// Descriptor implements the enum.Described interface for Day, so the generic
+// functions of the enum package, such as enum.Parse[Day], work with it
+func (Day) Descriptor() enum.Descriptor[Day] {
+	return _DayDescriptor{}
+}
+`
+
+const dayRegisterOut = `
+func init() {
+	enum.Register(enum.Type{
+		Name:      "Day",
+		Package:   "example.com/test",
+		Transform: "noop",
+		Values: []enum.Value{
+			{Name: "Monday", Value: Day(0)},
+			{Name: "Tuesday", Value: Day(1)},
+			{Name: "Wednesday", Value: Day(2)},
+			{Name: "Thursday", Value: Day(3)},
+			{Name: "Friday", Value: Day(4)},
+			{Name: "Saturday", Value: Day(5)},
+			{Name: "Sunday", Value: Day(6)},
+		},
+		Parse: func(s string) (any, error) {
+			v, err := DayString(s)
+			if err != nil {
+				return nil, err
+			}
+			return v, nil
+		},
+	})
+}
+`
+
+const levelDocIn = `type Level int
+const (
+	// Info is the default level.
+	// It logs everything.
+	Info Level = iota // Informational
+	Warn
+)
+`
+
+const levelInfoOut = `
+const _LevelName = "InfoWarn"
+
+var _LevelIndex = [...]uint8{0, 4, 8}
+
+func (i Level) String() string {
+	if i < 0 || i >= Level(len(_LevelIndex)-1) {
+		return fmt.Sprintf("Level(%d)", i)
+	}
+	return _LevelName[_LevelIndex[i]:_LevelIndex[i+1]]
+}
+
+var _LevelValues = []Level{0, 1}
+
+var _LevelNameToValueMap = map[string]Level{
+	_LevelName[0:4]: 0,
+	_LevelName[4:8]: 1,
+}
+
+// LevelString retrieves an enum value from the enum constants string name.
+// Throws an error if the param is not part of the enum.
+func LevelString(s string) (Level, error) {
+	if val, ok := _LevelNameToValueMap[s]; ok {
+		return val, nil
+	}
+	return 0, fmt.Errorf("%s does not belong to Level values", s)
+}
+
+// LevelValues returns all values of the enum
+func LevelValues() []Level {
+	return _LevelValues
+}
+
+// IsALevel returns "true" if the value is listed in the enum definition. "false" otherwise
+func (i Level) IsALevel() bool {
+	for _, v := range _LevelValues {
+		if i == v {
+			return true
+		}
+	}
+	return false
+}
+
+var _LevelInfo = []enum.ValueInfo[Level]{
+	{GoName: "Info", Name: "Info", Value: Level(0), Doc: "Info is the default level.\nIt logs everything.", Comment: "Informational"},
+	{GoName: "Warn", Name: "Warn", Value: Level(1)},
+}
+
+// LevelInfo returns the Go identifier, the string representation, the doc
+// comment and the line comment of all values of the enum, sorted by value
+func LevelInfo() []enum.ValueInfo[Level] {
+	info := make([]enum.ValueInfo[Level], len(_LevelInfo))
+	copy(info, _LevelInfo)
+	return info
+}
+
+// GoName returns the name of the constant that declares the value in the Go
+// source, or "" if the value is not listed in the enum definition
+func (i Level) GoName() string {
+	for _, v := range _LevelInfo {
+		if v.Value == i {
+			return v.GoName
+		}
+	}
+	return ""
+}
+
+// Doc returns the doc comment of the constant that declares the value, or ""
+// if it has none
+func (i Level) Doc() string {
+	for _, v := range _LevelInfo {
+		if v.Value == i {
+			return v.Doc
+		}
+	}
+	return ""
+}
+`
+
+const dayPluginOut = `
+// DayGoNames lists the Go names of the values of Day
+var DayGoNames = []string{"Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday", "Sunday"}
+`
+
+const levelTS = `/** Values of the Go enum test.Level, as encoded in JSON. */
+export type Level =
+  /**
+   * Info is the default level.
+   * It logs everything.
+   */
+  | "Info"
+  | "Warn";
+
+/** Every Level, in the order of their numbers. */
+export const LevelValues: readonly Level[] = [
+  "Info",
+  "Warn",
+];
+
+/** Reports whether v is a Level. */
+export function isLevel(v: unknown): v is Level {
+  return (LevelValues as readonly unknown[]).includes(v);
+}
+`
+
+const dayObjectTS = `/** Values of the Go enum test.Day, as encoded in JSON. */
+export const Day = {
+  Monday: "monday",
+  Tuesday: "tuesday",
+  Wednesday: "wednesday",
+  Thursday: "thursday",
+  Friday: "friday",
+  Saturday: "saturday",
+  Sunday: "sunday",
+} as const;
+
+export type Day = (typeof Day)[keyof typeof Day];
+
+/** Reports whether v is a Day. */
+export function isDay(v: unknown): v is Day {
+  return (Object.values(Day) as unknown[]).includes(v);
+}
+
+/**
+ * Numbers of the Day values, which JSON accepts in place of the names, as
+ * numbers or as strings of their digits. The numbers beyond
+ * Number.MAX_SAFE_INTEGER are given as strings, which keep them exact.
+ */
+export const DayNumbers = {
+  "monday": 0,
+  "tuesday": 1,
+  "wednesday": 2,
+  "thursday": 3,
+  "friday": 4,
+  "saturday": 5,
+  "sunday": 6,
+} as const;
+
+export type DayNumber = (typeof DayNumbers)[keyof typeof DayNumbers];
+
+/** Reports whether v is the number of a Day. */
+export function isDayNumber(v: unknown): v is DayNumber {
+  return (Object.values(DayNumbers) as unknown[]).includes(v);
+}
+`
+
+// The numbers of Huge and Tiny are beyond Number.MAX_SAFE_INTEGER.
+const bigIn = `type Big int64
+const (
+	Small Big = 1
+	Huge  Big = 1 << 60
+	Tiny  Big = -1 << 60
+	Edge  Big = 1<<53 - 1
+)
+`
+
+const bigNumericTS = `/** Values of the Go enum test.Big, as encoded in JSON. */
+export type Big =
+  | "Tiny"
+  | "Small"
+  | "Edge"
+  | "Huge";
+
+/** Every Big, in the order of their numbers. */
+export const BigValues: readonly Big[] = [
+  "Tiny",
+  "Small",
+  "Edge",
+  "Huge",
+];
+
+/** Reports whether v is a Big. */
+export function isBig(v: unknown): v is Big {
+  return (BigValues as readonly unknown[]).includes(v);
+}
+
+/**
+ * Numbers of the Big values, which JSON accepts in place of the names, as
+ * numbers or as strings of their digits. The numbers beyond
+ * Number.MAX_SAFE_INTEGER are given as strings, which keep them exact.
+ */
+export const BigNumbers = {
+  "Tiny": "-1152921504606846976",
+  "Small": 1,
+  "Edge": 9007199254740991,
+  "Huge": "1152921504606846976",
+} as const;
+
+export type BigNumber = (typeof BigNumbers)[keyof typeof BigNumbers];
+
+/** Reports whether v is the number of a Big. */
+export function isBigNumber(v: unknown): v is BigNumber {
+  return (Object.values(BigNumbers) as unknown[]).includes(v);
+}
+`
+
+const levelJSONSchema = `{
+  "$schema": "https://json-schema.org/draft/2020-12/schema",
+  "$defs": {
+    "Level": {
+      "type": "string",
+      "enum": [
+        "Info",
+        "Warn",
+        "Error"
+      ],
+      "x-enum-varnames": [
+        "Info",
+        "Warn",
+        "Error"
+      ],
+      "x-enum-descriptions": [
+        "Informational: \"it's fine\"",
+        "",
+        "Errors only"
+      ]
+    }
+  }
+}
+`
+
+const levelOpenAPI = `components:
+  schemas:
+    Level:
+      anyOf:
+        - type: string
+          enum:
+            - "info"
+            - "warn"
+            - "error"
+          x-enum-varnames:
+            - Info
+            - Warn
+            - Error
+          x-enum-descriptions:
+            - "Informational: \"it's fine\""
+            - ""
+            - "Errors only"
+        - type: string
+          description: "The names of the values in any case"
+          pattern: "^(?:[Iiİ][Nn][Ff][Oo]|[Ww][Aa][Rr][Nn]|[Ee][Rr][Rr][Oo][Rr])$"
+        - type: integer
+          enum:
+            - 0
+            - 1
+            - 2
+          x-enum-varnames:
+            - Info
+            - Warn
+            - Error
+          x-enum-descriptions:
+            - "Informational: \"it's fine\""
+            - ""
+            - "Errors only"
+        - type: string
+          description: "The numbers of the values as strings of their digits"
+          pattern: "^(?:[+-]?0+|\\+?0*1|\\+?0*2)$"
+`
+
+const dayProtoOut = `
+// ToDayProto returns the number of the value in the Day proto enum, the value
+// plus one, or 0, DAY_UNSPECIFIED, if it isn't a value of the enum
+func ToDayProto[P ~int32](i Day) P {
+	if !i.IsADay() {
+		return 0
+	}
+	return P(i) + 1
+}
+
+// DayFromProto returns the value with the given number in the Day proto enum,
+// the number minus one. It returns an error for DAY_UNSPECIFIED and for unknown numbers
+func DayFromProto[P ~int32](p P) (Day, error) {
+	i := Day(p - 1)
+	if p <= 0 || int64(i) != int64(p)-1 || !i.IsADay() {
+		return 0, fmt.Errorf("%d is not a Day proto enum number", p)
+	}
+	return i, nil
+}
+
+// DayFromProtoOr returns the value with the given number in the Day proto enum,
+// or def for DAY_UNSPECIFIED and for unknown numbers
+func DayFromProtoOr[P ~int32](p P, def Day) Day {
+	if i, err := DayFromProto(p); err == nil {
+		return i
+	}
+	return def
+}
+`
+
+const levelProto = `syntax = "proto3";
+
+package test;
+
+enum Level {
+  LEVEL_UNSPECIFIED = 0;
+  // Info is the default level.
+  // It logs everything.
+  LEVEL_INFO = 1; // Informational
+  LEVEL_WARN = 2;
+}
+`
+
+const levelPackageProto = `syntax = "proto3";
+
+package acme.logging.v1;
+
+enum Level {
+  LEVEL_UNSPECIFIED = 0;
+  LEVEL_INFO = 1; // Informational: "it's fine"
+  LEVEL_WARN = 2;
+  LEVEL_ERROR = 3; // Errors only
+}
+`
+
+// Without a 0 value, the numbers are still the values plus one.
+const gapProto = `syntax = "proto3";
+
+package test;
+
+enum Gap {
+  GAP_UNSPECIFIED = 0;
+  GAP_TWO = 3;
+  GAP_THREE = 4;
+  GAP_FIVE = 6;
+  GAP_SIX = 7;
+  GAP_SEVEN = 8;
+  GAP_EIGHT = 9;
+  GAP_NINE = 10;
+  GAP_ELEVEN = 12;
+}
+`
+
+const camelGraphQLSchema = `enum Camel {
+  first
+  second
+  third
+  fourth
+  fifth
+  sixth
+  seventh
+}
+`
+
+func TestGolden(t *testing.T) {
+	for _, test := range golden {
+		runGoldenTest(t, test)
+	}
+}
+
+func TestGoldenFiles(t *testing.T) {
+	for _, test := range goldenFiles {
+		g := generateGolden(t, test.name, test.input, test.flags, test.options)
+		var got *sideFile
+		for _, f := range g.sideFiles {
+			if f.name == test.file {
+				got = f
+			}
+		}
+		if got == nil {
+			t.Errorf("%s: no side file %s", test.name, test.file)
+			continue
+		}
+		if got.buf.String() != test.output {
+			t.Errorf("%s: got\n====\n%s====\nexpected\n====\n%s", test.file, got.buf.String(), test.output)
+		}
+	}
+}
+
+func TestGoldenDDLAlter(t *testing.T) {
+	prev := filepath.Join(t.TempDir(), "day.sql")
+	err := ioutil.WriteFile(prev, []byte(dayPreviousDDL), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	g := generateGolden(t, "day", dayIn, noFlags, map[string]string{DDLDialect: DDLPostgres, DDLPrevious: prev})
+	for _, f := range g.sideFiles {
+		if f.name == "day_string.alter.sql" {
+			if f.buf.String() != dayAlterDDL {
+				t.Errorf("%s: got\n====\n%s====\nexpected\n====\n%s", f.name, f.buf.String(), dayAlterDDL)
+			}
+			return
+		}
+	}
+	t.Errorf("no side file day_string.alter.sql")
+}
+
+func runGoldenTest(t *testing.T, test Golden) {
+	g := generateGolden(t, test.name, test.input, test.flags, test.options)
+	src, err := g.format()
+	if err != nil {
+		t.Fatalf("%s: %s", test.name, err)
+	}
+	got := string(src)
+	if got != test.output {
+		t.Errorf("%s: got\n====\n%s====\nexpected\n====%s", test.name, got, test.output)
+	}
+}
+
+// generateGolden runs the generator over a package made of the input, whose
+// first line declares the type to generate for.
+func generateGolden(t *testing.T, name, input string, flags map[string]bool, options map[string]string) *Generator {
+	g := &Generator{outputName: name + "_string.go"}
+	file := name + ".go"
+	source := "package test\n" + input
+
+	dir, err := ioutil.TempDir("", "stringer")
+	if err != nil {
+		t.Error(err)
+	}
+	defer func() {
+		err = os.RemoveAll(dir)
+		if err != nil {
+			t.Error(err)
+		}
+	}()
+
+	absFile := filepath.Join(dir, file)
+	err = ioutil.WriteFile(absFile, []byte(source), 0644)
+	if err != nil {
+		t.Error(err)
+	}
+	g.parsePackage(context.Background(), []string{absFile})
+	// The import path of a list of files is unknown; give it one for -register.
+	g.pkg.path = "example.com/test"
+	// Extract the name and type of the constant from the first line.
+	tokens := strings.SplitN(input, " ", 3)
+	if len(tokens) != 3 {
+		t.Fatalf("%s: need type declaration on first line", name)
+	}
+	g.generate(tokens[1], flags, options)
+	return g
+}
+
+func init() {
+	RegisterPlugin(Plugin{
+		Name:    "testplugin",
+		Usage:   "if true, a <Type>GoNames variable will be generated",
+		Imports: []string{"fmt"},
+		Generate: func(w io.Writer, e *Enum) error {
+			names := make([]string, len(e.Values))
+			for i, v := range e.Values {
+				names[i] = fmt.Sprintf("%q", v.GoName)
+			}
+			fmt.Fprintf(w, "// %sGoNames lists the Go names of the values of %s\n", e.TypeName, e.TypeName)
+			fmt.Fprintf(w, "var %sGoNames = []string{%s}\n", e.TypeName, strings.Join(names, ", "))
+			return nil
+		},
+	})
+}
+
+func TestGenerate(t *testing.T) {
+	dir, err := ioutil.TempDir("", "stringer")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	file := filepath.Join(dir, "day.go")
+	err = ioutil.WriteFile(file, []byte("package test\n"+dayIn+"type Empty int\ntype Neg int\nconst MinusOne Neg = -1\ntype Big uint64\nconst Huge Big = 1 << 63\n"), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	files, err := Generate(context.Background(), Config{
+		Types:    []string{"Day"},
+		Patterns: []string{file},
+		Args:     []string{"-type=Day", "-ddl=postgres"},
+		Options:  Options{SQL: true, DDL: DDLPostgres, Plugins: []string{"testplugin"}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(files) != 2 {
+		t.Fatalf("got %d files; expected 2", len(files))
+	}
+	if name := filepath.Join(dir, "day_string.go"); files[0].Name != name {
+		t.Errorf("got Go file %s; expected %s", files[0].Name, name)
+	}
+	header := "// Code generated by \"enumer -type=Day -ddl=postgres\"; DO NOT EDIT.\n"
+	if !strings.HasPrefix(string(files[0].Data), header) {
+		t.Errorf("Go file doesn't start with the header:\n%s", files[0].Data)
+	}
+	if !strings.Contains(string(files[0].Data), "func (i Day) Value() (driver.Value, error)") {
+		t.Errorf("Go file has no Value method:\n%s", files[0].Data)
+	}
+	if !strings.Contains(string(files[0].Data), "var DayGoNames = []string{") {
+		t.Errorf("Go file has no plugin output:\n%s", files[0].Data)
+	}
+	if n := strings.Count(string(files[0].Data), "\t\"fmt\"\n"); n != 1 {
+		t.Errorf("Go file imports fmt %d times:\n%s", n, files[0].Data)
+	}
+	ddl := "-- " + header[3:] + "\n" + dayPostgresDDL
+	if files[1].Name != filepath.Join(dir, "day_string.sql") || string(files[1].Data) != ddl {
+		t.Errorf("got DDL file %s\n====\n%s====\nexpected\n====\n%s", files[1].Name, files[1].Data, ddl)
+	}
+
+	for _, test := range []struct {
+		cfg Config
+		err string
+	}{
+		{Config{Patterns: []string{file}}, "no type names"},
+		{Config{Types: []string{"Day"}, Patterns: []string{file}, Options: Options{Transform: "title"}}, `unknown transformation "title"`},
+		{Config{Types: []string{"Day"}, Patterns: []string{file}, Options: Options{ORMs: ORMGorm}}, "-orm requires -sql"},
+		{Config{Types: []string{"Day"}, Patterns: []string{file}, Options: Options{SQL: true, ORMs: "gorm,ent,gorm"}}, `ORM "gorm" is listed twice`},
+		{Config{Types: []string{"Empty"}, Patterns: []string{file}}, "no values defined for type Empty"},
+		{Config{Types: []string{"Day"}, Patterns: []string{file}, Options: Options{Register: true}}, "-register needs the import path of the package"},
+		{Config{Types: []string{"Day"}, Patterns: []string{file}, Options: Options{Plugins: []string{"nope"}}}, `unknown plugin "nope"`},
+		{Config{Types: []string{"Day"}, Patterns: []string{file}, Options: Options{EmitTemplates: []string{"day.tmpl"}}}, `invalid -emit-template "day.tmpl"`},
+		{Config{Types: []string{"Neg"}, Patterns: []string{file}, Options: Options{Proto: true}}, "the value -1 of Neg can't be numbered in a proto enum"},
+		{Config{Types: []string{"Day"}, Patterns: []string{file}, Options: Options{Proto: true, ProtoPackage: "acme..v1"}}, `invalid proto package "acme..v1"`},
+		{Config{Types: []string{"Day"}, Patterns: []string{file}, Options: Options{Null: true, Text: true, EmptyValue: "Monday"}}, "the value 0 of Day has an empty name, which NullDay encodes as null"},
+		{Config{Types: []string{"Day"}, Patterns: []string{file}, Options: Options{Null: true, Binary: true, BinaryEncoding: BinaryName, EmptyValue: "Monday"}}, "the value 0 of Day has an empty name, which NullDay encodes as null"},
+		{Config{Types: []string{"Big"}, Patterns: []string{file}, Options: Options{SQL: true, SQLStorage: SQLInt}}, "the value 9223372036854775808 of Big doesn't fit the int64 of -sql=int"},
+	} {
+		_, err := Generate(context.Background(), test.cfg)
+		if err == nil || !strings.HasPrefix(err.Error(), test.err) {
+			t.Errorf("%+v: got error %v; expected %s", test.cfg, err, test.err)
+		}
+	}
+}
+
+func TestRegisterPluginConflicts(t *testing.T) {
+	for _, name := range []string{"json", "type", "testplugin"} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("registering a plugin named %s didn't panic", name)
+				}
+			}()
+			RegisterPlugin(Plugin{Name: name, Generate: func(io.Writer, *Enum) error { return nil }})
+		}()
+	}
+	for _, p := range Plugins() {
+		if p.builtin {
+			t.Errorf("Plugins lists the built-in format %s", p.Name)
+		}
+	}
+}
+
+func TestBuiltinOrder(t *testing.T) {
+	var names []string
+	for _, p := range allPlugins() {
+		if p.builtin {
+			names = append(names, p.Name)
+		}
+	}
+	if strings.Join(names, ",") != strings.Join(builtinOrder, ",") {
+		t.Errorf("got built-in formats %v; expected %v", names, builtinOrder)
+	}
+
+	dir, err := ioutil.TempDir("", "stringer")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	file := filepath.Join(dir, "day.go")
+	if err := ioutil.WriteFile(file, []byte("package test\n"+dayIn), 0644); err != nil {
+		t.Fatal(err)
+	}
+	files, err := Generate(context.Background(), Config{
+		Types:    []string{"Day"},
+		Patterns: []string{file},
+		Options:  Options{SQL: true, JSON: true, YAML: true, Text: true, Binary: true, XML: true},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The formats come in the order they had before they became plugins.
+	last := -1
+	for _, method := range []string{"MarshalJSON", "MarshalText", "MarshalYAML", "Value", "MarshalBinary", "MarshalXML"} {
+		i := strings.Index(string(files[0].Data), "func (i Day) "+method+"(")
+		if i <= last {
+			t.Errorf("%s is out of order:\n%s", method, files[0].Data)
+		}
+		last = i
+	}
+}
+
+func TestGenerateTemplates(t *testing.T) {
+	dir, err := ioutil.TempDir("", "stringer")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	file := filepath.Join(dir, "day.go")
+	if err := ioutil.WriteFile(file, []byte("package test\n"+dayIn+gapIn), 0644); err != nil {
+		t.Fatal(err)
+	}
+	templates := filepath.Join(dir, "templates")
+	if err := os.Mkdir(templates, 0755); err != nil {
+		t.Fatal(err)
+	}
+	generate := func(name, text string) ([]File, error) {
+		if err := ioutil.WriteFile(filepath.Join(templates, name+".tmpl"), []byte(text), 0644); err != nil {
+			t.Fatal(err)
+		}
+		defer os.Remove(filepath.Join(templates, name+".tmpl"))
+		return Generate(context.Background(), Config{
+			Types:    []string{"Day", "Gap"},
+			Patterns: []string{file},
+			Options:  Options{JSON: true, Templates: templates},
+		})
+	}
+
+	files, err := generate("textMethods", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	files, err = generate("jsonMethods", `
+// MarshalJSON encodes a {{.TypeName}} as its name.
+func (d {{.TypeName}}) MarshalJSON() ([]byte, error) {
+	return json.Marshal(d.String())
+}
+`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(files[0].Data), "// MarshalJSON encodes a Day as its name.\nfunc (d Day) MarshalJSON()") {
+		t.Errorf("the jsonMethods template wasn't overridden:\n%s", files[0].Data)
+	}
+	// The override needs errors, which isn't imported, and no longer uses
+	// encoding/json.
+	files, err = generate("jsonMethods", `
+// MarshalJSON refuses to encode a {{.TypeName}}.
+func (d {{.TypeName}}) MarshalJSON() ([]byte, error) {
+	return nil, errors.New("{{.TypeName}} can't be encoded")
+}
+`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(files[0].Data), "\t\"errors\"\n") || strings.Contains(string(files[0].Data), "\"encoding/json\"") {
+		t.Errorf("the imports weren't fixed:\n%s", files[0].Data)
+	}
+	// The String method of multiple runs is a template too, which ranges
+	// over the runs.
+	files, err = generate("stringMultipleRuns", `func (g {{.TypeName}}) String() string {
+	switch {
+{{- range $n, $run := .Runs}}
+	case {{.First}} <= g && g <= {{.Last}}:
+		return _{{$.TypeName}}Name_{{$n}}[_{{$.TypeName}}Index_{{$n}}[g-{{.First}}]:_{{$.TypeName}}Index_{{$n}}[g-{{.First}}+1]]
+{{- end}}
+	}
+	return fmt.Sprintf("{{.TypeName}}(%d)", g)
+}
+`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(files[0].Data), "func (g Gap) String() string {\n\tswitch {\n\tcase 2 <= g && g <= 3:\n") {
+		t.Errorf("the stringMultipleRuns template wasn't overridden:\n%s", files[0].Data)
+	}
+
+	for _, test := range []struct {
+		name, text, err string
+	}{
+		{"jsonMethods", "func (d {{.TypeName}}) MarshalJSON() ([]byte, error) { return json.Encode(d) }", "templates generate invalid Go code"},
+		{"jsonMethods", "func (d {{.TypeName}}) {", "templates generate invalid Go code"},
+		{"jsonMethods", "{{.Nope}}", "executing template"},
+		{"jsonMethod", "", "unknown template jsonMethod"},
+	} {
+		_, err := generate(test.name, test.text)
+		if err == nil || !strings.Contains(err.Error(), test.err) {
+			t.Errorf("%s %q: got error %v; expected %s", test.name, test.text, err, test.err)
+		}
+	}
+}
+
+func TestGenerateEmitTemplates(t *testing.T) {
+	dir, err := ioutil.TempDir("", "stringer")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	file := filepath.Join(dir, "day.go")
+	if err := ioutil.WriteFile(file, []byte("package test\n"+dayIn+"type Level int\nconst (\n\tLow Level = iota\n\tHigh\n)\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	tmpl := filepath.Join(dir, "list.tmpl")
+	text := "{{.Package}}:{{range .Enums}} {{.TypeName}}[{{range .Values}} {{.GoName}}={{.Name}}({{.Literal}}){{end}} ]{{end}}\n"
+	if err := ioutil.WriteFile(tmpl, []byte(text), 0644); err != nil {
+		t.Fatal(err)
+	}
+	output := filepath.Join(dir, "days.txt")
+
+	files, err := Generate(context.Background(), Config{
+		Types:    []string{"Day", "Level"},
+		Patterns: []string{file},
+		Options:  Options{Transform: ToLower, EmitTemplates: []string{tmpl + ":" + output}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(files) != 2 {
+		t.Fatalf("got %d files; expected 2", len(files))
+	}
+	// The template is rendered once, with both types.
+	expected := "test: Day[ Monday=monday(0) Tuesday=tuesday(1) Wednesday=wednesday(2) Thursday=thursday(3) Friday=friday(4) Saturday=saturday(5) Sunday=sunday(6) ] Level[ Low=low(0) High=high(1) ]\n"
+	if files[1].Name != output || string(files[1].Data) != expected {
+		t.Errorf("got file %s\n====\n%s====\nexpected\n====\n%s", files[1].Name, files[1].Data, expected)
+	}
+
+	// A template may address any of the types: it isn't executed before the
+	// later ones are known.
+	if err := ioutil.WriteFile(tmpl, []byte("{{(index .Enums 1).TypeName}}\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	files, err = Generate(context.Background(), Config{
+		Types:    []string{"Day", "Level"},
+		Patterns: []string{file},
+		Options:  Options{EmitTemplates: []string{tmpl + ":" + output}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(files[1].Data) != "Level\n" {
+		t.Errorf("got file %s\n====\n%s====\nexpected Level", files[1].Name, files[1].Data)
+	}
+
+	if err := ioutil.WriteFile(tmpl, []byte("{{.Nope}}"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	_, err = Generate(context.Background(), Config{
+		Types:    []string{"Day"},
+		Patterns: []string{file},
+		Options:  Options{EmitTemplates: []string{tmpl + ":" + output}},
+	})
+	if err == nil || !strings.Contains(err.Error(), "can't evaluate field Nope") {
+		t.Errorf("got error %v; expected a template execution error", err)
+	}
+}
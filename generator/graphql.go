@@ -0,0 +1,114 @@
+package generator
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+const graphQLMethods = `
+var _{{.TypeName}}GraphQLNames = map[{{.TypeName}}]string{
+{{- range .Values}}
+	{{.Literal}}: {{printf "%q" .GraphQLName}},
+{{- end}}
+}
+
+// MarshalGQL implements the graphql.Marshaler interface for {{.TypeName}}
+func (i {{.TypeName}}) MarshalGQL(w io.Writer) {
+	name, ok := _{{.TypeName}}GraphQLNames[i]
+	if !ok {
+		name = i.String()
+	}
+	io.WriteString(w, strconv.Quote(name))
+}
+
+// UnmarshalGQL implements the graphql.Unmarshaler interface for {{.TypeName}}
+func (i *{{.TypeName}}) UnmarshalGQL(v interface{}) error {
+	s, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("{{.TypeName}} should be a string, got %T", v)
+	}
+	for val, name := range _{{.TypeName}}GraphQLNames {
+		if name == s {
+			*i = val
+			return nil
+		}
+	}
+	return fmt.Errorf("%s does not belong to {{.TypeName}} GraphQL values", s)
+}
+`
+
+// graphQLNames returns the GraphQL name of every value in the runs, in order.
+// The names are built from the Go names, with the prefix trimmed, rather than
+// from the string representation: GraphQL enum values must be identifiers and
+// are conventionally written in upper snake case, which is the transformation
+// used unless another one is given.
+func (g *Generator) graphQLNames(runs [][]Value, prefix string, transform string) []string {
+	if transform == "" {
+		transform = ToSnakeUpper
+	}
+	var values []Value
+	for _, run := range runs {
+		for _, v := range run {
+			values = append(values, Value{name: strings.TrimPrefix(v.goName, prefix)})
+		}
+	}
+	g.transformValueNames(values, transform, "")
+	names := make([]string, len(values))
+	for i, v := range values {
+		if !isGraphQLName(v.name) {
+			failf("%q is not a valid GraphQL enum value name", v.name)
+		}
+		names[i] = v.name
+	}
+	return names
+}
+
+// isGraphQLName reports whether s can be used as a GraphQL enum value:
+// it must match /[_A-Za-z][_0-9A-Za-z]*/ and can't be true, false or null.
+func isGraphQLName(s string) bool {
+	if s == "" || s == "true" || s == "false" || s == "null" {
+		return false
+	}
+	for i, r := range s {
+		switch {
+		case r == '_', 'a' <= r && r <= 'z', 'A' <= r && r <= 'Z':
+		case i > 0 && '0' <= r && r <= '9':
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+func init() {
+	registerBuiltin(Plugin{
+		Name:    IncludeGraphQL,
+		Imports: []string{"io", "strconv"},
+		Generate: func(w io.Writer, e *Enum) error {
+			return e.g.executeTo(w, "graphQLMethods")
+		},
+	})
+}
+
+// buildGraphQLSchema writes the GraphQL declaration of the enum to the schema
+// side file. Line comments become the descriptions of the values.
+func (g *Generator) buildGraphQLSchema(runs [][]Value, typeName string, fileName string) {
+	b := g.sideFile(fileName, ".graphqls", "#")
+	if b.Len() > 0 {
+		b.WriteString("\n")
+	}
+	fmt.Fprintf(b, "enum %s {\n", typeName)
+	n := 0
+	for _, values := range runs {
+		for _, value := range values {
+			if value.comment != "" {
+				fmt.Fprintf(b, "  %s\n", strconv.Quote(value.comment))
+			}
+			fmt.Fprintf(b, "  %s\n", g.data.Values[n].GraphQLName)
+			n++
+		}
+	}
+	b.WriteString("}\n")
+}
@@ -0,0 +1,293 @@
+package generator
+
+import (
+	"fmt"
+	"io"
+)
+
+const nullType = `
+// Null{{.TypeName}} represents a {{.TypeName}} that may be null, in the style of sql.NullString.
+// Null is distinct from the zero value: it is encoded as SQL NULL, JSON null,
+// empty text, YAML null, no XML element or attribute, empty binary data or
+// GraphQL null, depending on the methods that are generated.
+type Null{{.TypeName}} struct {
+	{{.TypeName}}
+	Valid bool // Valid is true if {{.TypeName}} is not null
+}
+`
+
+const nullSQLMethods = `
+// Value implements the driver.Valuer interface for Null{{.TypeName}}
+func (n Null{{.TypeName}}) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+	return n.{{.TypeName}}.Value()
+}
+
+// Scan implements the sql.Scanner interface for Null{{.TypeName}}
+func (n *Null{{.TypeName}}) Scan(value interface{}) error {
+	if value == nil {
+		n.{{.TypeName}}, n.Valid = 0, false
+		return nil
+	}
+	var val {{.TypeName}}
+	if err := val.Scan(value); err != nil {
+		return err
+	}
+	n.{{.TypeName}}, n.Valid = val, true
+	return nil
+}
+`
+
+const nullJSONMethods = `
+// MarshalJSON implements the json.Marshaler interface for Null{{.TypeName}}
+func (n Null{{.TypeName}}) MarshalJSON() ([]byte, error) {
+	if !n.Valid {
+		return []byte("null"), nil
+	}
+	return n.{{.TypeName}}.MarshalJSON()
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface for Null{{.TypeName}}
+func (n *Null{{.TypeName}}) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		n.{{.TypeName}}, n.Valid = 0, false
+		return nil
+	}
+	var val {{.TypeName}}
+	if err := val.UnmarshalJSON(data); err != nil {
+		return err
+	}
+	n.{{.TypeName}}, n.Valid = val, true
+	return nil
+}
+`
+
+const nullTextMethods = `
+// MarshalText implements the encoding.TextMarshaler interface for Null{{.TypeName}}
+func (n Null{{.TypeName}}) MarshalText() ([]byte, error) {
+	if !n.Valid {
+		return []byte{}, nil
+	}
+	return n.{{.TypeName}}.MarshalText()
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface for Null{{.TypeName}}
+func (n *Null{{.TypeName}}) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		n.{{.TypeName}}, n.Valid = 0, false
+		return nil
+	}
+	var val {{.TypeName}}
+	if err := val.UnmarshalText(text); err != nil {
+		return err
+	}
+	n.{{.TypeName}}, n.Valid = val, true
+	return nil
+}
+`
+
+const nullYAMLMarshalMethod = `
+// MarshalYAML implements a YAML Marshaler for Null{{.TypeName}}
+func (n Null{{.TypeName}}) MarshalYAML() (interface{}, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+	return n.{{.TypeName}}.MarshalYAML()
+}
+`
+
+const nullYAMLUnmarshalMethod = `
+// UnmarshalYAML implements a YAML Unmarshaler for Null{{.TypeName}}
+func (n *Null{{.TypeName}}) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s *string
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	if s == nil {
+		n.{{.TypeName}}, n.Valid = 0, false
+		return nil
+	}
+	var val {{.TypeName}}
+	if err := val.UnmarshalYAML(unmarshal); err != nil {
+		return err
+	}
+	n.{{.TypeName}}, n.Valid = val, true
+	return nil
+}
+`
+
+const nullYAMLV3UnmarshalMethod = `
+// UnmarshalYAML implements the yaml.Unmarshaler interface for Null{{.TypeName}}.
+// yaml.v3 doesn't call it for null, and leaves the Null{{.TypeName}} untouched:
+// decode into a zero value or into a *Null{{.TypeName}} to read null as invalid.
+func (n *Null{{.TypeName}}) UnmarshalYAML(value *yaml.Node) error {
+	var val {{.TypeName}}
+	if err := val.UnmarshalYAML(value); err != nil {
+		return err
+	}
+	n.{{.TypeName}}, n.Valid = val, true
+	return nil
+}
+`
+
+const nullXMLMethods = `
+// MarshalXML implements the xml.Marshaler interface for Null{{.TypeName}}.
+// Null is encoded as no element.
+func (n Null{{.TypeName}}) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if !n.Valid {
+		return nil
+	}
+	return n.{{.TypeName}}.MarshalXML(e, start)
+}
+
+// UnmarshalXML implements the xml.Unmarshaler interface for Null{{.TypeName}}
+func (n *Null{{.TypeName}}) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	var val {{.TypeName}}
+	if err := val.UnmarshalXML(d, start); err != nil {
+		return err
+	}
+	n.{{.TypeName}}, n.Valid = val, true
+	return nil
+}
+
+// MarshalXMLAttr implements the xml.MarshalerAttr interface for Null{{.TypeName}}.
+// Null is encoded as no attribute.
+func (n Null{{.TypeName}}) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	if !n.Valid {
+		return xml.Attr{}, nil
+	}
+	return n.{{.TypeName}}.MarshalXMLAttr(name)
+}
+
+// UnmarshalXMLAttr implements the xml.UnmarshalerAttr interface for Null{{.TypeName}}
+func (n *Null{{.TypeName}}) UnmarshalXMLAttr(attr xml.Attr) error {
+	var val {{.TypeName}}
+	if err := val.UnmarshalXMLAttr(attr); err != nil {
+		return err
+	}
+	n.{{.TypeName}}, n.Valid = val, true
+	return nil
+}
+`
+
+const nullBinaryMethods = `
+// MarshalBinary implements the encoding.BinaryMarshaler interface for Null{{.TypeName}}.
+// Null is encoded as no data.
+func (n Null{{.TypeName}}) MarshalBinary() ([]byte, error) {
+	if !n.Valid {
+		return []byte{}, nil
+	}
+	return n.{{.TypeName}}.MarshalBinary()
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface for Null{{.TypeName}}
+func (n *Null{{.TypeName}}) UnmarshalBinary(data []byte) error {
+	if len(data) == 0 {
+		n.{{.TypeName}}, n.Valid = 0, false
+		return nil
+	}
+	var val {{.TypeName}}
+	if err := val.UnmarshalBinary(data); err != nil {
+		return err
+	}
+	n.{{.TypeName}}, n.Valid = val, true
+	return nil
+}
+`
+
+const nullGraphQLMethods = `
+// MarshalGQL implements the graphql.Marshaler interface for Null{{.TypeName}}
+func (n Null{{.TypeName}}) MarshalGQL(w io.Writer) {
+	if !n.Valid {
+		io.WriteString(w, "null")
+		return
+	}
+	n.{{.TypeName}}.MarshalGQL(w)
+}
+
+// UnmarshalGQL implements the graphql.Unmarshaler interface for Null{{.TypeName}}
+func (n *Null{{.TypeName}}) UnmarshalGQL(v interface{}) error {
+	if v == nil {
+		n.{{.TypeName}}, n.Valid = 0, false
+		return nil
+	}
+	var val {{.TypeName}}
+	if err := val.UnmarshalGQL(v); err != nil {
+		return err
+	}
+	n.{{.TypeName}}, n.Valid = val, true
+	return nil
+}
+`
+
+const nullFlagMethods = `
+// Set implements the flag.Value interface for Null{{.TypeName}}
+func (n *Null{{.TypeName}}) Set(s string) error {
+	var val {{.TypeName}}
+	if err := val.Set(s); err != nil {
+		return err
+	}
+	n.{{.TypeName}}, n.Valid = val, true
+	return nil
+}
+`
+
+// The null format generates the Null<Type> wrapper, with methods for each of
+// the encodings that are generated for the type. Every method decoding into
+// the type is overridden, so that none of them leaves Valid unset.
+func init() {
+	registerBuiltin(Plugin{
+		Name:     IncludeNull,
+		Generate: generateNullType,
+	})
+}
+
+func generateNullType(w io.Writer, e *Enum) error {
+	// Null is empty text and empty binary data: a value named "" would
+	// decode as null.
+	if e.flags[IncludeText] || e.flags[IncludeBinary] && e.options[BinaryEncoding] == BinaryName {
+		for _, v := range e.Values {
+			if v.Name == "" {
+				return fmt.Errorf("the value %s of %s has an empty name, which Null%s encodes as null", v.Literal, e.TypeName, e.TypeName)
+			}
+		}
+	}
+	templates := []string{"nullType"}
+	if e.flags[IncludeSQL] {
+		templates = append(templates, "nullSQLMethods")
+	}
+	if e.flags[IncludeJSON] {
+		templates = append(templates, "nullJSONMethods")
+	}
+	if e.flags[IncludeText] {
+		templates = append(templates, "nullTextMethods")
+	}
+	if e.flags[IncludeYAML] {
+		templates = append(templates, "nullYAMLMarshalMethod")
+		if e.options[YAMLVersion] == YAMLv3 {
+			templates = append(templates, "nullYAMLV3UnmarshalMethod")
+		} else {
+			templates = append(templates, "nullYAMLUnmarshalMethod")
+		}
+	}
+	if e.flags[IncludeXML] {
+		templates = append(templates, "nullXMLMethods")
+	}
+	if e.flags[IncludeBinary] {
+		templates = append(templates, "nullBinaryMethods")
+	}
+	if e.flags[IncludeGraphQL] {
+		templates = append(templates, "nullGraphQLMethods")
+	}
+	if e.flags[IncludeFlag] {
+		templates = append(templates, "nullFlagMethods")
+	}
+	for _, name := range templates {
+		if err := e.g.executeTo(w, name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,88 @@
+package generator
+
+import (
+	"io"
+	"strings"
+)
+
+const (
+	ORMGorm = "gorm"
+	ORMEnt  = "ent"
+)
+
+const entValuesMethod = `
+// Values returns the string representations of all values of the enum. It
+// implements the ent EnumValues interface for {{.TypeName}}
+func ({{.TypeName}}) Values() []string {
+	values := make([]string, len(_{{.TypeName}}Values))
+	for i, v := range _{{.TypeName}}Values {
+		values[i] = v.String()
+	}
+	return values
+}
+`
+
+const gormDataTypeMethod = `
+// GormDataType implements the gorm schema.GormDataTypeInterface for {{.TypeName}}
+func ({{.TypeName}}) GormDataType() string {
+	return "{{if .IntStorage}}int{{else}}string{{end}}"
+}
+`
+
+const gormDBDataTypeMethod = `
+// GormDBDataType implements the gorm migrator.GormDBDataTypeInterface for {{.TypeName}}.
+// The PostgreSQL column type is the enum type declared by enumer -ddl=postgres.
+func ({{.TypeName}}) GormDBDataType(db *gorm.DB, field *schema.Field) string {
+	switch db.Dialector.Name() {
+	case "postgres":
+		return {{printf "%q" .SQLName}}
+	case "mysql":
+		return {{printf "%q" (printf "ENUM(%s)" .SQLValues)}}
+	case "sqlite":
+		return fmt.Sprintf("text CHECK (%s IN (%s))", field.DBName, {{printf "%q" .SQLValues}})
+	}
+	return ""
+}
+`
+
+// includesORM reports whether the comma-separated list of ORMs includes orm.
+func includesORM(orms, orm string) bool {
+	for _, o := range strings.Split(orms, ",") {
+		if o == orm {
+			return true
+		}
+	}
+	return false
+}
+
+// The orm format generates the methods the listed ORMs use to map the enum
+// to columns, on top of the Valuer and Scanner.
+func init() {
+	registerBuiltin(Plugin{
+		Name: ORMs,
+		imports: func(options map[string]string) []string {
+			if includesORM(options[ORMs], ORMGorm) && options[SQLStorage] != SQLInt {
+				return []string{"gorm.io/gorm", "gorm.io/gorm/schema"}
+			}
+			return nil
+		},
+		Generate: func(w io.Writer, e *Enum) error {
+			for _, orm := range strings.Split(e.options[ORMs], ",") {
+				var err error
+				switch orm {
+				case ORMEnt:
+					err = e.g.executeTo(w, "entValuesMethod")
+				case ORMGorm:
+					err = e.g.executeTo(w, "gormDataTypeMethod")
+					if err == nil && e.options[SQLStorage] != SQLInt {
+						err = e.g.executeTo(w, "gormDBDataTypeMethod")
+					}
+				}
+				if err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+	})
+}
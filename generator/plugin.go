@@ -0,0 +1,259 @@
+package generator
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"sort"
+	"sync"
+)
+
+// Plugin generates code for the enum types, on top of the built-in methods,
+// when it is enabled with Options.Plugins or with its command line flag.
+type Plugin struct {
+	Name     string   // Name of the plugin and of its command line flag
+	Usage    string   // Usage of the command line flag
+	Imports  []string // Import paths of the packages the generated code uses
+	Generate func(w io.Writer, e *Enum) error
+
+	builtin bool                                     // Whether the plugin is a built-in format, enabled by an option
+	imports func(options map[string]string) []string // Import paths that depend on the options, for built-in formats
+}
+
+// Enum is the model of an enum type that plugins, and the templates of
+// Options.EmitTemplates through EmitData, generate code from.
+type Enum struct {
+	Package  string        // Name of the package that declares the type
+	TypeName string        // Name of the Go type
+	Values   []EnumValue   // Values of the enum, sorted by value, without duplicates
+	Runs     [][]EnumValue // Values split into runs of consecutive values
+
+	g       *Generator        // Generator whose templates the built-in formats execute
+	flags   map[string]bool   // Flags of the run, for the built-in formats
+	options map[string]string // Options of the run, for the built-in formats
+}
+
+// EnumValue is a value of an Enum.
+type EnumValue struct {
+	GoName  string // Name of the constant in the Go source
+	Name    string // String representation, after the transformation
+	Literal string // The value as a Go literal, such as "3" or "-1"
+	Signed  bool   // Whether the type of the enum is signed
+	Comment string // Line comment of the constant, if any
+	Doc     string // Doc comment of the constant, if any
+}
+
+var (
+	pluginsMu sync.RWMutex
+	plugins   = make(map[string]*Plugin)
+)
+
+// RegisterPlugin makes the plugin available to Generate and to the enumer
+// command line, which adds a flag for it. It is meant to be called from the
+// init function of the package that defines the plugin; it panics if the name
+// is taken by another plugin or by a built-in flag.
+func RegisterPlugin(p Plugin) {
+	pluginsMu.Lock()
+	defer pluginsMu.Unlock()
+	if p.Name == "" || p.Generate == nil {
+		panic("generator: RegisterPlugin needs a name and a Generate function")
+	}
+	if builtinFlag(p.Name) {
+		panic("generator: plugin name " + p.Name + " is a built-in flag")
+	}
+	if _, dup := plugins[p.Name]; dup {
+		panic("generator: RegisterPlugin called twice for plugin " + p.Name)
+	}
+	plugins[p.Name] = &p
+}
+
+// builtinOrder lists the built-in formats in the order their code is
+// generated, which is the order they had before they became plugins.
+var builtinOrder = []string{
+	IncludeJSON,
+	IncludeText,
+	IncludeYAML,
+	IncludeSQL,
+	IncludeBinary,
+	IncludeGraphQL,
+	IncludeXML,
+	ORMs,
+	IncludeFlag,
+	IncludeList,
+	IncludeCompletion,
+	IncludeIter,
+	IncludeEnum,
+	IncludeInfo,
+	IncludeRegister,
+	IncludeSlog,
+	IncludeFormat,
+	IncludeNull,
+	IncludePGArray,
+}
+
+// registerBuiltin registers a built-in format as a plugin. Its name is the
+// flag or option of Options that enables it, which must be listed in
+// builtinOrder, and its Generate function executes the templates of the
+// generator, so they can be overridden with -templates.
+func registerBuiltin(p Plugin) {
+	pluginsMu.Lock()
+	defer pluginsMu.Unlock()
+	if _, dup := plugins[p.Name]; dup {
+		panic("generator: registerBuiltin called twice for plugin " + p.Name)
+	}
+	if builtinIndex(p.Name) < 0 {
+		panic("generator: built-in plugin " + p.Name + " is not in builtinOrder")
+	}
+	p.builtin = true
+	plugins[p.Name] = &p
+}
+
+// builtinIndex returns the position of the named built-in format in
+// builtinOrder, or -1.
+func builtinIndex(name string) int {
+	for i, n := range builtinOrder {
+		if n == name {
+			return i
+		}
+	}
+	return -1
+}
+
+// Plugins returns the registered plugins, sorted by name. The built-in
+// formats, which have their own fields in Options, are left out.
+func Plugins() []Plugin {
+	var list []Plugin
+	for _, p := range allPlugins() {
+		if !p.builtin {
+			list = append(list, p)
+		}
+	}
+	return list
+}
+
+// allPlugins returns the registered plugins: the built-in formats, in the
+// order of builtinOrder, then the others, sorted by name.
+func allPlugins() []Plugin {
+	pluginsMu.RLock()
+	defer pluginsMu.RUnlock()
+	list := make([]Plugin, 0, len(plugins))
+	for _, p := range plugins {
+		list = append(list, *p)
+	}
+	sort.Slice(list, func(i, j int) bool {
+		if list[i].builtin != list[j].builtin {
+			return list[i].builtin
+		}
+		if list[i].builtin {
+			return builtinIndex(list[i].Name) < builtinIndex(list[j].Name)
+		}
+		return list[i].Name < list[j].Name
+	})
+	return list
+}
+
+// lookupPlugin returns the registered plugin with the given name, or nil.
+func lookupPlugin(name string) *Plugin {
+	pluginsMu.RLock()
+	defer pluginsMu.RUnlock()
+	return plugins[name]
+}
+
+// builtinFlag reports whether name is the name of a built-in flag.
+func builtinFlag(name string) bool {
+	switch name {
+	case "type", "output", "comment", EmitTemplate:
+		return true
+	}
+	bools, strs := (&Options{}).fields()
+	_, isBool := bools[name]
+	_, isString := strs[name]
+	return isBool || isString
+}
+
+// newEnum returns the plugin model of the enum type with the given runs.
+func newEnum(pkg, typeName string, runs [][]Value) *Enum {
+	e := &Enum{Package: pkg, TypeName: typeName}
+	for _, values := range runs {
+		var run []EnumValue
+		for _, v := range values {
+			run = append(run, newEnumValue(v))
+		}
+		e.Runs = append(e.Runs, run)
+		e.Values = append(e.Values, run...)
+	}
+	return e
+}
+
+// newEnumValue returns the plugin model of the value.
+func newEnumValue(v Value) EnumValue {
+	return EnumValue{
+		GoName:  v.goName,
+		Name:    v.name,
+		Literal: v.String(),
+		Signed:  v.signed,
+		Comment: v.comment,
+		Doc:     v.doc,
+	}
+}
+
+// buildPlugins runs the enabled plugins, in the order of allPlugins, for the
+// enum type: the built-in formats if builtin is set, or else the registered
+// plugins.
+func (g *Generator) buildPlugins(runs [][]Value, typeName string, flags map[string]bool, options map[string]string, builtin bool) {
+	var e *Enum
+	for _, p := range allPlugins() {
+		if p.builtin != builtin || !p.enabled(flags, options) {
+			continue
+		}
+		if e == nil {
+			e = newEnum(g.pkg.name, typeName, runs)
+			e.g, e.flags, e.options = g, flags, options
+		}
+		var b bytes.Buffer
+		if err := p.Generate(&b, e); err != nil {
+			if p.builtin {
+				failf("%s", err)
+			}
+			failf("plugin %s: %s", p.Name, err)
+		}
+		g.Printf("\n%s", b.Bytes())
+	}
+}
+
+// enabled reports whether the plugin is enabled by its flag or, for the
+// built-in formats enabled by a string option such as -orm, by its option.
+func (p *Plugin) enabled(flags map[string]bool, options map[string]string) bool {
+	if p.builtin {
+		if _, ok := options[p.Name]; ok {
+			return true
+		}
+	}
+	return flags[p.Name]
+}
+
+// pluginImports returns the import paths of the enabled plugins, built-in
+// formats included.
+func pluginImports(flags map[string]bool, options map[string]string) []string {
+	var imports []string
+	for _, p := range allPlugins() {
+		if p.enabled(flags, options) {
+			imports = append(imports, p.Imports...)
+			if p.imports != nil {
+				imports = append(imports, p.imports(options)...)
+			}
+		}
+	}
+	return imports
+}
+
+// validatePlugins reports the first flag that is neither built in nor the
+// name of a registered plugin.
+func validatePlugins(flags map[string]bool) error {
+	for name := range flags {
+		if !builtinFlag(name) && lookupPlugin(name) == nil {
+			return fmt.Errorf("unknown plugin %q", name)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,153 @@
+package generator
+
+import (
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/pascaldekloe/name"
+)
+
+// protoEnum holds the proto enum declaration of an enum type.
+type protoEnum struct {
+	prefix  string   // Prefix of the names, the type name in upper snake case followed by an underscore
+	names   []string // Names of the values, in the order of the runs
+	numbers []int64  // Numbers of the values, in the order of the runs
+}
+
+// protoPrefix returns the prefix of the names of the values of the proto enum
+// of the type: its name in upper snake case followed by an underscore.
+func protoPrefix(typeName string) string {
+	return strings.ToUpper(name.Delimit(typeName, '_')) + "_"
+}
+
+// newProtoEnum returns the proto enum of the type. The names of the values
+// are built from their Go names, with the prefix trimmed, in upper snake case
+// and prefixed with the type name, as the protobuf style guide asks. The
+// number 0 is taken by <TYPE>_UNSPECIFIED, so the number of each value is its
+// Go value plus one, whatever the other values: adding a value, even 0, never
+// renumbers the others. Negative values, and values from math.MaxInt32 up,
+// can't be numbered.
+func (g *Generator) newProtoEnum(runs [][]Value, typeName, prefix string) *protoEnum {
+	p := &protoEnum{prefix: protoPrefix(typeName)}
+	var values []Value
+	for _, run := range runs {
+		for _, v := range run {
+			values = append(values, Value{name: strings.TrimPrefix(v.goName, prefix)})
+		}
+	}
+	g.transformValueNames(values, ToSnakeUpper, "")
+	seen := map[string]bool{p.prefix + "UNSPECIFIED": true}
+	n := 0
+	for _, run := range runs {
+		for _, v := range run {
+			protoName := p.prefix + values[n].name
+			if !isProtoName(protoName) {
+				failf("%q is not a valid proto enum value name", protoName)
+			}
+			if seen[protoName] {
+				failf("proto enum value name %s is used twice", protoName)
+			}
+			seen[protoName] = true
+			// The bit pattern of a signed value converts back to it.
+			number := int64(v.value) + 1
+			if (!v.signed && v.value > math.MaxInt32) || number <= 0 || number > math.MaxInt32 {
+				failf("the value %s of %s can't be numbered in a proto enum", &v, typeName)
+			}
+			p.names = append(p.names, protoName)
+			p.numbers = append(p.numbers, number)
+			n++
+		}
+	}
+	return p
+}
+
+// isProtoName reports whether s is a valid proto identifier.
+func isProtoName(s string) bool {
+	for i, r := range s {
+		switch {
+		case r == '_', 'a' <= r && r <= 'z', 'A' <= r && r <= 'Z':
+		case i > 0 && '0' <= r && r <= '9':
+		default:
+			return false
+		}
+	}
+	return s != ""
+}
+
+// isProtoPackage reports whether s is a valid proto package name, a dotted
+// list of identifiers.
+func isProtoPackage(s string) bool {
+	for _, part := range strings.Split(s, ".") {
+		if !isProtoName(part) {
+			return false
+		}
+	}
+	return true
+}
+
+const protoFuncs = `
+// To{{.TypeName}}Proto returns the number of the value in the {{.TypeName}} proto enum, the value
+// plus one, or 0, {{.ProtoPrefix}}UNSPECIFIED, if it isn't a value of the enum
+func To{{.TypeName}}Proto[P ~int32](i {{.TypeName}}) P {
+	if !i.IsA{{.TypeName}}() {
+		return 0
+	}
+	return P(i) + 1
+}
+
+// {{.TypeName}}FromProto returns the value with the given number in the {{.TypeName}} proto enum,
+// the number minus one. It returns an error for {{.ProtoPrefix}}UNSPECIFIED and for unknown numbers
+func {{.TypeName}}FromProto[P ~int32](p P) ({{.TypeName}}, error) {
+	i := {{.TypeName}}(p - 1)
+	if p <= 0 || int64(i) != int64(p)-1 || !i.IsA{{.TypeName}}() {
+		return 0, fmt.Errorf("%d is not a {{.TypeName}} proto enum number", p)
+	}
+	return i, nil
+}
+
+// {{.TypeName}}FromProtoOr returns the value with the given number in the {{.TypeName}} proto enum,
+// or def for {{.ProtoPrefix}}UNSPECIFIED and for unknown numbers
+func {{.TypeName}}FromProtoOr[P ~int32](p P, def {{.TypeName}}) {{.TypeName}} {
+	if i, err := {{.TypeName}}FromProto(p); err == nil {
+		return i
+	}
+	return def
+}
+`
+
+// buildProto writes the proto enum declaration of the type to the proto
+// side file, in the proto package protoPackage, and generates the
+// To<Type>Proto, <Type>FromProto and <Type>FromProtoOr functions converting
+// between the type and any type based on int32, such as the ones
+// protoc-gen-go generates for the enum.
+func (g *Generator) buildProto(runs [][]Value, typeName, prefix, fileName, protoPackage string) {
+	p := g.newProtoEnum(runs, typeName, prefix)
+
+	b := g.sideFile(fileName, ".proto", "//")
+	if b.Len() == 0 {
+		fmt.Fprintf(b, "syntax = \"proto3\";\n\n")
+		fmt.Fprintf(b, "package %s;\n", protoPackage)
+	}
+	fmt.Fprintf(b, "\nenum %s {\n", typeName)
+	fmt.Fprintf(b, "  %sUNSPECIFIED = 0;\n", p.prefix)
+	n := 0
+	for _, values := range runs {
+		for _, value := range values {
+			if value.doc != "" {
+				for _, line := range strings.Split(value.doc, "\n") {
+					fmt.Fprintf(b, "  //%s\n", strings.TrimRight(" "+line, " "))
+				}
+			}
+			fmt.Fprintf(b, "  %s = %d;", p.names[n], p.numbers[n])
+			if value.comment != "" && value.comment != value.name {
+				fmt.Fprintf(b, " // %s", value.comment)
+			}
+			b.WriteString("\n")
+			n++
+		}
+	}
+	b.WriteString("}\n")
+
+	g.execute("protoFuncs")
+}
@@ -0,0 +1,241 @@
+package generator
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"unicode"
+)
+
+// enumSchema is the JSON Schema of the JSON encoding of an enum type. It is
+// written as is to the JSON Schema file, and as YAML to the OpenAPI file.
+type enumSchema struct {
+	Type         string        `json:"type,omitempty"`
+	Description  string        `json:"description,omitempty"`
+	Pattern      string        `json:"pattern,omitempty"`
+	Enum         []interface{} `json:"enum,omitempty"` // Names, as strings, or numbers, as json.Numbers
+	VarNames     []string      `json:"x-enum-varnames,omitempty"`
+	Descriptions []string      `json:"x-enum-descriptions,omitempty"`
+	AnyOf        []*enumSchema `json:"anyOf,omitempty"`
+	typeName     string        // Name of the enum type, the key of the schema.
+}
+
+// newEnumSchema returns the schema of the JSON values UnmarshalJSON accepts:
+// the names of the values, in the cases the match allows, and, if numeric is
+// set, their numbers, as JSON numbers or as the strings <Type>String parses
+// with strconv.Atoi. Line comments become the descriptions of the values.
+func newEnumSchema(runs [][]Value, typeName string, match CaseMatch, numeric bool) *enumSchema {
+	names := &enumSchema{Type: "string"}
+	numbers := &enumSchema{Type: "integer"}
+	var descriptions []string
+	described := false
+	var patterns, numberPatterns []string
+	for _, values := range runs {
+		for _, v := range values {
+			names.Enum = append(names.Enum, v.name)
+			names.VarNames = append(names.VarNames, v.goName)
+			numbers.Enum = append(numbers.Enum, json.Number(v.String()))
+			numbers.VarNames = append(numbers.VarNames, v.goName)
+			description := ""
+			if v.comment != v.name {
+				description = v.comment
+				described = described || description != ""
+			}
+			descriptions = append(descriptions, description)
+			if pattern, ok := caseInsensitivePattern(v.name, match); ok {
+				patterns = append(patterns, pattern)
+			}
+			numberPatterns = append(numberPatterns, numberPattern(v))
+		}
+	}
+	if described {
+		names.Descriptions = descriptions
+		numbers.Descriptions = descriptions
+	}
+
+	schema := &enumSchema{typeName: typeName}
+	schema.AnyOf = append(schema.AnyOf, names)
+	if match != CaseNone && len(patterns) > 0 {
+		schema.AnyOf = append(schema.AnyOf, &enumSchema{
+			Type:        "string",
+			Description: "The names of the values in any case",
+			Pattern:     "^(?:" + strings.Join(patterns, "|") + ")$",
+		})
+	}
+	if numeric {
+		schema.AnyOf = append(schema.AnyOf, numbers, &enumSchema{
+			Type:        "string",
+			Description: "The numbers of the values as strings of their digits",
+			Pattern:     "^(?:" + strings.Join(numberPatterns, "|") + ")$",
+		})
+	}
+	if len(schema.AnyOf) == 1 {
+		names.typeName = typeName
+		return names
+	}
+	return schema
+}
+
+var (
+	caseInversesOnce sync.Once
+	lowerInverse     map[rune][]rune // Runes that unicode.ToLower maps to another rune, by that rune
+	upperInverse     map[rune][]rune // Runes that unicode.ToUpper maps to another rune, by that rune
+)
+
+// caseInverses builds lowerInverse and upperInverse.
+func caseInverses() {
+	lowerInverse, upperInverse = make(map[rune][]rune), make(map[rune][]rune)
+	for r := rune(0); r <= unicode.MaxRune; r++ {
+		if lower := unicode.ToLower(r); lower != r {
+			lowerInverse[lower] = append(lowerInverse[lower], r)
+		}
+		if upper := unicode.ToUpper(r); upper != r {
+			upperInverse[upper] = append(upperInverse[upper], r)
+		}
+	}
+}
+
+// caseInsensitivePattern returns a regular expression, in the syntax common
+// to Go and ECMAScript, matching the strings that <Type>String matches with
+// the name s: those whose lower or upper case, as by strings.ToLower or
+// strings.ToUpper, is s, or else those equal to s under Unicode case folding,
+// as by strings.EqualFold. Each rune of s thus matches the runes that map to
+// it, such as the Kelvin sign for k, and not only its ASCII case. It returns
+// false if no string matches s.
+func caseInsensitivePattern(s string, match CaseMatch) (string, bool) {
+	caseInversesOnce.Do(caseInverses)
+	var b strings.Builder
+	for _, r := range s {
+		var class []rune
+		switch match {
+		case CaseLower:
+			if unicode.ToLower(r) == r {
+				class = append([]rune{r}, lowerInverse[r]...)
+			}
+		case CaseUpper:
+			if unicode.ToUpper(r) == r {
+				class = append([]rune{r}, upperInverse[r]...)
+			}
+		default:
+			class = []rune{r}
+			for f := unicode.SimpleFold(r); f != r; f = unicode.SimpleFold(f) {
+				class = append(class, f)
+			}
+		}
+		switch len(class) {
+		case 0:
+			return "", false
+		case 1:
+			b.WriteString(regexp.QuoteMeta(string(r)))
+		default:
+			sort.Slice(class, func(i, j int) bool { return class[i] < class[j] })
+			fmt.Fprintf(&b, "[%s]", string(class))
+		}
+	}
+	return b.String(), true
+}
+
+// numberPattern returns a regular expression, in the syntax common to Go and
+// ECMAScript, matching the strings that strconv.Atoi parses as the number of
+// the value: its digits, with leading zeros and a sign allowed.
+func numberPattern(v Value) string {
+	// <Type>String compares the values converted to int, so the bits of the
+	// value are read as an int64, whatever the type of the enum.
+	n := int64(v.value)
+	switch {
+	case n == 0:
+		return `[+-]?0+`
+	case n < 0:
+		return `-0*` + strings.TrimPrefix(strconv.FormatInt(n, 10), "-")
+	}
+	return `\+?0*` + strconv.FormatInt(n, 10)
+}
+
+// buildSchemas adds the schema of the enum to the JSON Schema and OpenAPI
+// side files that are enabled. Several types generated in one run are
+// declared in the same document, so the files are written again for each.
+func (g *Generator) buildSchemas(runs [][]Value, typeName string, flags map[string]bool, options map[string]string) {
+	g.schemas = append(g.schemas, newEnumSchema(runs, typeName, caseMatch(flags[IgnoreCase], options[TransformMethod]), flags[AllowNumeric]))
+	if flags[IncludeJSONSchema] {
+		b := g.sideFile(options[IncludeJSONSchema], ".schema.json", "")
+		b.Reset()
+		b.WriteString("{\n")
+		b.WriteString("  \"$schema\": \"https://json-schema.org/draft/2020-12/schema\",\n")
+		b.WriteString("  \"$defs\": {\n")
+		for i, schema := range g.schemas {
+			data, err := json.MarshalIndent(schema, "    ", "  ")
+			if err != nil {
+				failf("encoding the JSON Schema of %s: %s", schema.typeName, err)
+			}
+			fmt.Fprintf(b, "    %s: %s", strconv.Quote(schema.typeName), data)
+			if i < len(g.schemas)-1 {
+				b.WriteString(",")
+			}
+			b.WriteString("\n")
+		}
+		b.WriteString("  }\n")
+		b.WriteString("}\n")
+	}
+	if flags[IncludeOpenAPI] {
+		b := g.sideFile(options[IncludeOpenAPI], ".openapi.yaml", "#")
+		b.Reset()
+		b.WriteString("components:\n")
+		b.WriteString("  schemas:\n")
+		for _, schema := range g.schemas {
+			fmt.Fprintf(b, "    %s:\n", schema.typeName)
+			writeYAMLSchema(b, "      ", schema)
+		}
+	}
+}
+
+// writeYAMLSchema writes the schema as a YAML mapping indented with indent.
+// Strings are written in the double-quoted style, which accepts the escapes
+// of Go string literals.
+func writeYAMLSchema(b *bytes.Buffer, indent string, s *enumSchema) {
+	if s.Type != "" {
+		fmt.Fprintf(b, "%stype: %s\n", indent, s.Type)
+	}
+	if s.Description != "" {
+		fmt.Fprintf(b, "%sdescription: %s\n", indent, strconv.Quote(s.Description))
+	}
+	if s.Pattern != "" {
+		fmt.Fprintf(b, "%spattern: %s\n", indent, strconv.Quote(s.Pattern))
+	}
+	if s.Enum != nil {
+		fmt.Fprintf(b, "%senum:\n", indent)
+		for _, value := range s.Enum {
+			if name, ok := value.(string); ok {
+				fmt.Fprintf(b, "%s  - %s\n", indent, strconv.Quote(name))
+			} else {
+				fmt.Fprintf(b, "%s  - %s\n", indent, value)
+			}
+		}
+	}
+	if s.VarNames != nil {
+		fmt.Fprintf(b, "%sx-enum-varnames:\n", indent)
+		for _, name := range s.VarNames {
+			fmt.Fprintf(b, "%s  - %s\n", indent, name)
+		}
+	}
+	if s.Descriptions != nil {
+		fmt.Fprintf(b, "%sx-enum-descriptions:\n", indent)
+		for _, description := range s.Descriptions {
+			fmt.Fprintf(b, "%s  - %s\n", indent, strconv.Quote(description))
+		}
+	}
+	if s.AnyOf != nil {
+		fmt.Fprintf(b, "%sanyOf:\n", indent)
+		for _, alt := range s.AnyOf {
+			var sub bytes.Buffer
+			writeYAMLSchema(&sub, indent+"    ", alt)
+			// The first key of each alternative goes on the line of its dash.
+			text := sub.String()
+			fmt.Fprintf(b, "%s  - %s", indent, text[len(indent)+4:])
+		}
+	}
+}
@@ -0,0 +1,323 @@
+package generator
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+)
+
+const valueMethod = `func (i {{.TypeName}}) Value() (driver.Value, error) {
+	return i.String(), nil
+}
+`
+
+const valueIntMethod = `func (i {{.TypeName}}) Value() (driver.Value, error) {
+	return int64(i), nil
+}
+`
+
+const scanMethod = `func (i *{{.TypeName}}) Scan(value interface{}) error {
+	if value == nil {
+		return nil
+	}
+
+	var val {{.TypeName}}
+	var exact bool
+	switch v := value.(type) {
+	case []byte:
+		return i.Scan(string(v))
+	case string:
+		s, err := {{.TypeName}}String(v)
+		if err != nil {
+{{if .IntStorage}}{{template "scanIntStringCheck" .}}
+{{end}}			return err
+		}
+		*i = s
+		return nil
+	case int64:
+		val = {{.TypeName}}(v)
+		exact = {{if not .Signed}}v >= 0 && {{end}}int64(val) == v
+	case int32:
+		val = {{.TypeName}}(v)
+		exact = {{if not .Signed}}v >= 0 && {{end}}int32(val) == v
+	case uint64:
+		val = {{.TypeName}}(v)
+		exact = {{if .Signed}}int64(v) >= 0 && {{end}}uint64(val) == v
+	case float64:
+		val = {{.TypeName}}(v)
+		exact = float64(val) == v
+	default:
+		return fmt.Errorf("cannot scan %T value %v into {{.TypeName}}", value, value)
+	}
+	if !exact || !val.IsA{{.TypeName}}() {
+		return fmt.Errorf("%T value %v is not a valid {{.TypeName}}", value, value)
+	}
+
+	*i = val
+	return nil
+}
+`
+
+// Integer columns read through a text protocol arrive as strings of digits.
+const scanIntStringCheck = `			if n, perr := strconv.ParseInt(v, 10, 64); perr == nil {
+				return i.Scan(n)
+			}`
+
+func init() {
+	registerBuiltin(Plugin{
+		Name:    IncludeSQL,
+		Imports: []string{"database/sql/driver"},
+		imports: func(options map[string]string) []string {
+			if options[SQLStorage] == SQLInt {
+				return []string{"strconv"}
+			}
+			return nil
+		},
+		Generate: func(w io.Writer, e *Enum) error {
+			value := "valueMethod"
+			if e.options[SQLStorage] == SQLInt {
+				// Value returns an int64: unsigned values from 1<<63 up would wrap.
+				for _, v := range e.Values {
+					if _, err := strconv.ParseInt(v.Literal, 10, 64); err != nil {
+						return fmt.Errorf("the value %s of %s doesn't fit the int64 of -sql=%s", v.Literal, e.TypeName, SQLInt)
+					}
+				}
+				value = "valueIntMethod"
+			}
+			if err := e.g.executeTo(w, value); err != nil {
+				return err
+			}
+			io.WriteString(w, "\n")
+			return e.g.executeTo(w, "scanMethod")
+		},
+	})
+}
+
+const pgArrayType = `
+// {{.TypeName}}Slice is a list of {{.TypeName}} values stored in a PostgreSQL array column,
+// such as text[] or an array of a native enum type.
+type {{.TypeName}}Slice []{{.TypeName}}
+
+// Value implements the driver.Valuer interface for {{.TypeName}}Slice. Every element
+// is quoted, so any string representation makes a valid array literal.
+func (s {{.TypeName}}Slice) Value() (driver.Value, error) {
+	if s == nil {
+		return nil, nil
+	}
+	var b strings.Builder
+	b.WriteByte('{')
+	for n, v := range s {
+		if !v.IsA{{.TypeName}}() {
+			return nil, fmt.Errorf("{{.TypeName}}Slice: element %d: invalid value %d", n, v)
+		}
+		if n > 0 {
+			b.WriteByte(',')
+		}
+		_{{.TypeName}}QuoteArrayElem(&b, v.String())
+	}
+	b.WriteByte('}')
+	return b.String(), nil
+}
+
+// Scan implements the sql.Scanner interface for {{.TypeName}}Slice. It parses a
+// one-dimensional PostgreSQL array literal, such as {a,b,"c d"}. NULL
+// elements can't be represented and are reported as errors; see
+// Null{{.TypeName}}Slice, generated with -null, for arrays that hold them.
+func (s *{{.TypeName}}Slice) Scan(value interface{}) error {
+	elems, err := _{{.TypeName}}ArrayElems(value)
+	if err != nil {
+		return err
+	}
+	if elems == nil {
+		*s = nil
+		return nil
+	}
+	list := make({{.TypeName}}Slice, 0, len(elems))
+	for n, elem := range elems {
+		if elem == nil {
+			return fmt.Errorf("{{.TypeName}}Slice: element %d is NULL", n)
+		}
+		val, err := {{.TypeName}}String(*elem)
+		if err != nil {
+			return fmt.Errorf("{{.TypeName}}Slice: element %d: %w", n, err)
+		}
+		list = append(list, val)
+	}
+	*s = list
+	return nil
+}
+
+// _{{.TypeName}}QuoteArrayElem writes s to b as a quoted element of a PostgreSQL array literal.
+func _{{.TypeName}}QuoteArrayElem(b *strings.Builder, s string) {
+	b.WriteByte('"')
+	for _, r := range s {
+		if r == '"' || r == '\\' {
+			b.WriteByte('\\')
+		}
+		b.WriteRune(r)
+	}
+	b.WriteByte('"')
+}
+
+// _{{.TypeName}}ArrayElems splits a one-dimensional PostgreSQL array literal, such as
+// {a,NULL,"c d"}, into its elements, with nil for the NULL ones. It returns a nil
+// slice for a NULL array.
+func _{{.TypeName}}ArrayElems(value interface{}) ([]*string, error) {
+	var str string
+	switch v := value.(type) {
+	case nil:
+		return nil, nil
+	case []byte:
+		str = string(v)
+	case string:
+		str = v
+	default:
+		return nil, fmt.Errorf("cannot scan %T value %v into a {{.TypeName}} array", value, value)
+	}
+	if len(str) < 2 || str[0] != '{' || str[len(str)-1] != '}' {
+		return nil, fmt.Errorf("malformed {{.TypeName}} array literal %q", str)
+	}
+
+	const space = " \t\n\v\f\r"
+	elems := strings.Trim(str[1:len(str)-1], space)
+	list := []*string{}
+	for n := 0; elems != ""; n++ {
+		if n > 0 {
+			if elems[0] != ',' {
+				return nil, fmt.Errorf("malformed {{.TypeName}} array literal %q", str)
+			}
+			elems = strings.TrimLeft(elems[1:], space)
+			if elems == "" {
+				return nil, fmt.Errorf("malformed {{.TypeName}} array literal %q", str)
+			}
+		}
+		if elems[0] == '"' {
+			var b strings.Builder
+			i := 1
+			for ; i < len(elems) && elems[i] != '"'; i++ {
+				if elems[i] == '\\' && i+1 < len(elems) {
+					i++
+				}
+				b.WriteByte(elems[i])
+			}
+			if i == len(elems) {
+				return nil, fmt.Errorf("malformed {{.TypeName}} array literal %q", str)
+			}
+			elem := b.String()
+			list = append(list, &elem)
+			elems = strings.TrimLeft(elems[i+1:], space)
+			continue
+		}
+		// An unquoted element ends at the next comma. Backslashes escape
+		// the byte that follows, which keeps it even if it is a comma or
+		// trailing whitespace, and an escaped NULL is the string NULL.
+		var b strings.Builder
+		escaped := false
+		end := 0 // Length of b up to its last escaped or non-space byte
+		i := 0
+		for ; i < len(elems) && elems[i] != ','; i++ {
+			switch c := elems[i]; {
+			case c == '\\':
+				if i+1 == len(elems) {
+					return nil, fmt.Errorf("malformed {{.TypeName}} array literal %q", str)
+				}
+				i++
+				b.WriteByte(elems[i])
+				escaped, end = true, b.Len()
+			case c == '"' || c == '{' || c == '}':
+				return nil, fmt.Errorf("malformed {{.TypeName}} array literal %q", str)
+			default:
+				b.WriteByte(c)
+				if strings.IndexByte(space, c) < 0 {
+					end = b.Len()
+				}
+			}
+		}
+		elem := b.String()[:end]
+		elems = elems[i:]
+		switch {
+		case elem == "" && !escaped:
+			return nil, fmt.Errorf("malformed {{.TypeName}} array literal %q", str)
+		case !escaped && strings.EqualFold(elem, "NULL"):
+			list = append(list, nil)
+		default:
+			list = append(list, &elem)
+		}
+	}
+	return list, nil
+}
+`
+
+const pgNullArrayType = `
+// Null{{.TypeName}}Slice is a list of Null{{.TypeName}} values stored in a PostgreSQL array
+// column. Unlike {{.TypeName}}Slice, it holds NULL elements, as invalid Null{{.TypeName}}s.
+type Null{{.TypeName}}Slice []Null{{.TypeName}}
+
+// Value implements the driver.Valuer interface for Null{{.TypeName}}Slice
+func (s Null{{.TypeName}}Slice) Value() (driver.Value, error) {
+	if s == nil {
+		return nil, nil
+	}
+	var b strings.Builder
+	b.WriteByte('{')
+	for n, v := range s {
+		if n > 0 {
+			b.WriteByte(',')
+		}
+		if !v.Valid {
+			b.WriteString("NULL")
+			continue
+		}
+		if !v.IsA{{.TypeName}}() {
+			return nil, fmt.Errorf("Null{{.TypeName}}Slice: element %d: invalid value %d", n, v.{{.TypeName}})
+		}
+		_{{.TypeName}}QuoteArrayElem(&b, v.String())
+	}
+	b.WriteByte('}')
+	return b.String(), nil
+}
+
+// Scan implements the sql.Scanner interface for Null{{.TypeName}}Slice. It parses a
+// one-dimensional PostgreSQL array literal, such as {a,NULL,"c d"}.
+func (s *Null{{.TypeName}}Slice) Scan(value interface{}) error {
+	elems, err := _{{.TypeName}}ArrayElems(value)
+	if err != nil {
+		return err
+	}
+	if elems == nil {
+		*s = nil
+		return nil
+	}
+	list := make(Null{{.TypeName}}Slice, len(elems))
+	for n, elem := range elems {
+		if elem == nil {
+			continue
+		}
+		val, err := {{.TypeName}}String(*elem)
+		if err != nil {
+			return fmt.Errorf("Null{{.TypeName}}Slice: element %d: %w", n, err)
+		}
+		list[n] = Null{{.TypeName}}{ {{.TypeName}}: val, Valid: true}
+	}
+	*s = list
+	return nil
+}
+`
+
+// The pgarray format generates the <Type>Slice type, and the Null<Type>Slice
+// type if the Null<Type> wrapper is generated too.
+func init() {
+	registerBuiltin(Plugin{
+		Name:    IncludePGArray,
+		Imports: []string{"database/sql/driver", "strings"},
+		Generate: func(w io.Writer, e *Enum) error {
+			if err := e.g.executeTo(w, "pgArrayType"); err != nil {
+				return err
+			}
+			if e.flags[IncludeNull] {
+				return e.g.executeTo(w, "pgNullArrayType")
+			}
+			return nil
+		},
+	})
+}
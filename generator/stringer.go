@@ -0,0 +1,1268 @@
+// Copyright 2014 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build go1.5
+
+// Package generator generates the Go code that adds useful methods to Go enums
+// (constants with a specific type). It is the library behind the enumer command.
+//
+//Please visit http://github.com/SpectraLogic/enumer for comprehensive documentation
+package generator
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"go/ast"
+	exact "go/constant"
+	"go/format"
+	"go/importer"
+	"go/token"
+	"go/types"
+	"golang.org/x/tools/go/packages"
+	goimports "golang.org/x/tools/imports"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"text/template"
+	"unicode"
+
+	"github.com/pascaldekloe/name"
+)
+
+const (
+	IncludeSQL        = "sql"
+	IncludeJSON       = "json"
+	IncludeYAML       = "yaml"
+	IncludeText       = "text"
+	IncludeBinary     = "binary"
+	IncludeXML        = "xml"
+	IncludeGraphQL    = "graphql"
+	IncludeNull       = "null"
+	IncludePGArray    = "pgarray"
+	IncludeFlag       = "flag"
+	IncludeList       = "list"
+	IncludeCompletion = "completion"
+	IncludeSlog       = "slog"
+	SlogGroup         = "sloggroup"
+	IncludeFormat     = "format"
+	IncludeIter       = "iter"
+	ValuesCopy        = "valuescopy"
+	IncludeEnum       = "enum"
+	IncludeRegister   = "register"
+	IncludeInfo       = "info"
+	IncludeTS         = "ts"
+	IncludeJSONSchema = "jsonschema"
+	IncludeOpenAPI    = "openapi"
+	IncludeProto      = "proto"
+	ProtoPackage      = "protopackage"
+	ListDedupe        = "listdedupe"
+	IgnoreCase        = "ignorecase"
+	AllowNumeric      = "numeric"
+	LineComment       = "linecomment"
+
+	TransformMethod = "transform"
+	TrimPrefix      = "trimprefix"
+	EmptyValue      = "empty"
+	BinaryEncoding  = "binaryencoding"
+	YAMLVersion     = "yamlversion"
+	SQLStorage      = "sqlstorage"
+	ORMs            = "orm"
+	DDLDialect      = "ddl"
+	DDLOutput       = "ddlout"
+	DDLPrevious     = "ddlprev"
+	ListSeparator   = "listsep"
+	EmitShells      = "emit"
+	GraphQLCase     = "graphqlcase"
+	GraphQLSchema   = "graphqlschema"
+	TSStyle         = "tsstyle"
+	TemplatesDir    = "templates"
+	EmitTemplate    = "emit-template"
+
+	ToUpper      = "upper"
+	ToLower      = "lower"
+	ToJSON       = "json"
+	ToKebab      = "kebab"
+	ToKebabUpper = "kebabu"
+	ToSnake      = "snake"
+	ToSnakeUpper = "snakeu"
+
+	BinaryVarint = "varint"
+	BinaryName   = "name"
+
+	YAMLv2 = "v2"
+	YAMLv3 = "v3"
+
+	SQLString = "string"
+	SQLInt    = "int"
+)
+
+// TransformationsText describes the supported transformations.
+const TransformationsText = `Supported transformations:
+        noop:   "EnumValue" - No transformation
+        upper:  "ENUMVALUE" - Upper case
+        lower:  "enumvalue" - Lower case
+        json:   "enumValue" - JSON case ("BBGun" becomes "bbGun", "MyBBGun" becomes "myBbGun")
+        snake:  "enum_value" - Snake case
+        snakeu: "ENUM_VALUE" - Snake upper case
+        kebab:  "enum-value" - Kebab case
+        kebabu: "ENUM-VALUE" - Kebab upper case
+`
+
+const supportedTransformations = "Supported transformations: noop, upper, lower, json, snake, snakeu, kebab, kebabu"
+
+var transformations = map[string]struct{}{
+	ToUpper:      struct{}{},
+	ToLower:      struct{}{},
+	ToJSON:       struct{}{},
+	ToKebab:      struct{}{},
+	ToKebabUpper: struct{}{},
+	ToSnake:      struct{}{},
+	ToSnakeUpper: struct{}{},
+	"noop":       struct{}{},
+}
+
+// Options selects the code generated for each type, on top of the String
+// method. The fields mirror the command line flags of the same names.
+type Options struct {
+	SQL               bool   // Implement the Scanner and Valuer interfaces
+	SQLStorage        string // How the enum is stored: SQLString (the default) or SQLInt
+	ORMs              string // Comma-separated list of ORMs to generate column mapping methods for
+	JSON              bool   // Generate json marshaling methods
+	YAML              bool   // Generate yaml marshaling methods
+	YAMLVersion       string // yaml package: YAMLv2 (the default) or YAMLv3
+	Text              bool   // Generate text marshaling methods
+	Binary            bool   // Generate binary marshaling methods
+	BinaryEncoding    string // Binary encoding: BinaryVarint (the default) or BinaryName
+	XML               bool   // Generate xml element and attribute marshaling methods
+	GraphQL           bool   // Generate gqlgen marshaling methods
+	GraphQLCase       string // Transformation of the GraphQL value names; default snakeu
+	GraphQLSchema     bool   // Write a GraphQL schema fragment declaring the enum
+	GraphQLSchemaFile string // Schema file name; default <output>.graphqls
+	PGArray           bool   // Generate a <Type>Slice type stored as a PostgreSQL array
+	Flag              bool   // Implement the flag.Value and pflag.Value interfaces
+	List              bool   // Generate a <Type>List type holding separated lists of values
+	ListSeparator     string // Separator of the values in a <Type>List; default ","
+	ListDedupe        bool   // Drop repeated values when parsing a <Type>List
+	Completion        bool   // Generate a <Type>Completions function
+	EmitShells        string // Comma-separated list of shells to write completion functions for
+	Slog              bool   // Implement the slog.LogValuer interface
+	SlogGroup         bool   // Log a group with the name and the number of the value
+	Format            bool   // Implement the fmt.Formatter and fmt.GoStringer interfaces
+	Iter              bool   // Generate the <Type>All, <Type>Names and <Type>Pairs iterators
+	ValuesCopy        bool   // Make <Type>Values return a copy of the values
+	Enum              bool   // Implement the interfaces of the enum package
+	Register          bool   // Register the enum in the registry of the enum package
+	Info              bool   // Generate a <Type>Info table describing the values
+	Null              bool   // Generate a Null<Type> wrapper
+	IgnoreCase        bool   // Ignore case when transforming from a string
+	AllowNumeric      bool   // Allow numeric values when transforming from a string
+	LineComment       bool   // Use the line comment text as printed text when present
+	Transform         string // Transformation of the value names; default noop
+	TrimPrefix        string // Prefix removed from each value name
+	EmptyValue        string // Value printed as an empty string
+	DDL               string // SQL dialect of the DDL to write: DDLPostgres, DDLMySQL or DDLSQLite
+	DDLOutput         string // DDL file name; default <output>.sql
+	DDLPrevious       string // Previously generated postgres DDL file to write ALTER TYPE statements against
+	TS                bool   // Write the TypeScript declaration of the enum
+	TSFile            string // TypeScript file name; default <output>.ts
+	TSStyle           string // Style of the TypeScript declaration: TSUnion (the default) or TSObject
+	JSONSchema        bool   // Write the JSON Schema of the JSON encoding of the enum
+	JSONSchemaFile    string // JSON Schema file name; default <output>.schema.json
+	OpenAPI           bool   // Write the OpenAPI component schema of the JSON encoding of the enum
+	OpenAPIFile       string // OpenAPI file name; default <output>.openapi.yaml
+	Proto             bool   // Write the proto enum declaration of the enum and generate conversions to its numbers
+	ProtoFile         string // Proto file name; default <output>.proto
+	ProtoPackage      string // Package of the proto enum; default the name of the Go package
+
+	Templates     string   // Directory of <name>.tmpl files replacing the templates of the same names, see TemplateNames
+	EmitTemplates []string // Templates rendered with the EmitData of the types, as "path.tmpl:out.ext"
+
+	Plugins []string // Names of the registered plugins to run, see RegisterPlugin
+}
+
+// fields returns every boolean and string option, keyed by the command line
+// flag names.
+func (o *Options) fields() (map[string]bool, map[string]string) {
+	return map[string]bool{
+		IncludeSQL:        o.SQL,
+		IncludeJSON:       o.JSON,
+		IncludeYAML:       o.YAML,
+		IncludeText:       o.Text,
+		IncludeBinary:     o.Binary,
+		IncludeXML:        o.XML,
+		IncludeGraphQL:    o.GraphQL,
+		GraphQLSchema:     o.GraphQLSchema,
+		IncludePGArray:    o.PGArray,
+		IncludeFlag:       o.Flag,
+		IncludeList:       o.List,
+		ListDedupe:        o.ListDedupe,
+		IncludeCompletion: o.Completion,
+		IncludeSlog:       o.Slog,
+		SlogGroup:         o.SlogGroup,
+		IncludeFormat:     o.Format,
+		IncludeIter:       o.Iter,
+		ValuesCopy:        o.ValuesCopy,
+		IncludeEnum:       o.Enum,
+		IncludeRegister:   o.Register,
+		IncludeInfo:       o.Info,
+		IncludeTS:         o.TS,
+		IncludeJSONSchema: o.JSONSchema,
+		IncludeOpenAPI:    o.OpenAPI,
+		IncludeProto:      o.Proto,
+		IncludeNull:       o.Null,
+		IgnoreCase:        o.IgnoreCase,
+		AllowNumeric:      o.AllowNumeric,
+		LineComment:       o.LineComment,
+	}, map[string]string{
+		TransformMethod:   o.Transform,
+		TrimPrefix:        o.TrimPrefix,
+		EmptyValue:        o.EmptyValue,
+		BinaryEncoding:    o.BinaryEncoding,
+		YAMLVersion:       o.YAMLVersion,
+		SQLStorage:        o.SQLStorage,
+		ORMs:              o.ORMs,
+		DDLDialect:        o.DDL,
+		DDLOutput:         o.DDLOutput,
+		DDLPrevious:       o.DDLPrevious,
+		ListSeparator:     o.ListSeparator,
+		EmitShells:        o.EmitShells,
+		GraphQLCase:       o.GraphQLCase,
+		GraphQLSchema:     o.GraphQLSchemaFile,
+		IncludeTS:         o.TSFile,
+		TSStyle:           o.TSStyle,
+		IncludeJSONSchema: o.JSONSchemaFile,
+		IncludeOpenAPI:    o.OpenAPIFile,
+		IncludeProto:      o.ProtoFile,
+		ProtoPackage:      o.ProtoPackage,
+		TemplatesDir:      o.Templates,
+	}
+}
+
+// maps returns the options as the flag and option maps the generator works
+// with, keyed by the command line flag names. Only the flags that are set and
+// the options that aren't empty are included; enabled plugins are flags too.
+func (o *Options) maps() (flags map[string]bool, options map[string]string) {
+	bools, strs := o.fields()
+	flags = make(map[string]bool)
+	for k, v := range bools {
+		if v {
+			flags[k] = true
+		}
+	}
+	for _, name := range o.Plugins {
+		flags[name] = true
+	}
+	options = make(map[string]string)
+	for k, v := range strs {
+		if v != "" {
+			options[k] = v
+		}
+	}
+	if options[TransformMethod] == "noop" {
+		delete(options, TransformMethod)
+	}
+	return flags, options
+}
+
+// Validate reports the first option that has an unknown value or that
+// conflicts with the other options.
+func (o *Options) Validate() error {
+	flags, options := o.maps()
+	if err := validate(flags, options); err != nil {
+		return err
+	}
+	if err := validateEmitTemplates(o.EmitTemplates); err != nil {
+		return err
+	}
+	return validatePlugins(flags)
+}
+
+func validate(flags map[string]bool, options map[string]string) error {
+	if transform, ok := options[TransformMethod]; ok {
+		if _, ok = transformations[transform]; !ok {
+			return fmt.Errorf("unknown transformation %q. %s", transform, supportedTransformations)
+		}
+	}
+
+	if transform, ok := options[GraphQLCase]; ok {
+		if _, ok = transformations[transform]; !ok {
+			return fmt.Errorf("unknown GraphQL transformation %q. %s", transform, supportedTransformations)
+		}
+	}
+
+	if encoding, ok := options[BinaryEncoding]; ok && encoding != BinaryVarint && encoding != BinaryName {
+		return fmt.Errorf("unknown binary encoding %q. Supported encodings: %s, %s", encoding, BinaryVarint, BinaryName)
+	}
+
+	if storage, ok := options[SQLStorage]; ok && storage != SQLString && storage != SQLInt {
+		return fmt.Errorf("unknown sql storage %q. Supported storages: %s, %s", storage, SQLString, SQLInt)
+	}
+
+	if dialect, ok := options[DDLDialect]; ok {
+		if dialect != DDLPostgres && dialect != DDLMySQL && dialect != DDLSQLite {
+			return fmt.Errorf("unknown DDL dialect %q. Supported dialects: %s, %s, %s", dialect, DDLPostgres, DDLMySQL, DDLSQLite)
+		}
+		if options[SQLStorage] == SQLInt {
+			return fmt.Errorf("DDL can't be written for enums stored as integers")
+		}
+		if _, ok := options[DDLPrevious]; ok && dialect != DDLPostgres {
+			return fmt.Errorf("only %s DDL can be altered", DDLPostgres)
+		}
+	}
+
+	if orms, ok := options[ORMs]; ok {
+		listed := make(map[string]bool)
+		for _, orm := range strings.Split(orms, ",") {
+			if orm != ORMGorm && orm != ORMEnt {
+				return fmt.Errorf("unknown ORM %q. Supported ORMs: %s, %s", orm, ORMGorm, ORMEnt)
+			}
+			if listed[orm] {
+				return fmt.Errorf("ORM %q is listed twice", orm)
+			}
+			listed[orm] = true
+			if orm == ORMEnt && options[SQLStorage] == SQLInt {
+				return fmt.Errorf("ent enums can't be stored as integers")
+			}
+		}
+		if !flags[IncludeSQL] {
+			return fmt.Errorf("-orm requires -sql")
+		}
+	}
+
+	if shells, ok := options[EmitShells]; ok {
+		for _, shell := range strings.Split(shells, ",") {
+			if shell != ShellBash && shell != ShellZsh && shell != ShellFish {
+				return fmt.Errorf("unknown shell %q. Supported shells: %s, %s, %s", shell, ShellBash, ShellZsh, ShellFish)
+			}
+		}
+	}
+
+	if style, ok := options[TSStyle]; ok && style != TSUnion && style != TSObject {
+		return fmt.Errorf("unknown TypeScript style %q. Supported styles: %s, %s", style, TSUnion, TSObject)
+	}
+
+	if version, ok := options[YAMLVersion]; ok && version != YAMLv2 && version != YAMLv3 {
+		return fmt.Errorf("unknown yaml version %q. Supported versions: %s, %s", version, YAMLv2, YAMLv3)
+	}
+
+	if pkg, ok := options[ProtoPackage]; ok && !isProtoPackage(pkg) {
+		return fmt.Errorf("invalid proto package %q", pkg)
+	}
+	return nil
+}
+
+// Config describes a run of the generator.
+type Config struct {
+	Types    []string // Names of the types to generate the methods for; at least one
+	Patterns []string // Directory, or Go files, of the package that declares the types; default "."
+	Output   string   // Name of the Go output file; default <dir>/<type>_string.go
+	Comments []string // Comments to include in the generated Go code
+	Args     []string // Command line arguments recorded in the "Code generated" headers
+	Options
+}
+
+// File is an output file of Generate.
+type File struct {
+	Name string // Name of the file
+	Data []byte // Contents of the file, including the "Code generated" header
+}
+
+// generateError carries an error that aborts the generation from the point
+// where it is detected, with failf, up to Generate.
+type generateError struct {
+	err error
+}
+
+// failf aborts the generation with the formatted error.
+func failf(format string, args ...interface{}) {
+	panic(generateError{fmt.Errorf(format, args...)})
+}
+
+// Generate generates the code for the types of the config. It returns the Go
+// file first, followed by the non-Go files, such as DDL or schema files, that
+// the options ask for. Nothing is written to disk.
+func Generate(ctx context.Context, cfg Config) (files []File, err error) {
+	if len(cfg.Types) == 0 {
+		return nil, errors.New("no type names")
+	}
+	flags, options := cfg.Options.maps()
+	if err := validate(flags, options); err != nil {
+		return nil, err
+	}
+	if err := validateEmitTemplates(cfg.EmitTemplates); err != nil {
+		return nil, err
+	}
+	if err := validatePlugins(flags); err != nil {
+		return nil, err
+	}
+
+	// We accept either one directory or a list of files. Which do we have?
+	patterns := cfg.Patterns
+	if len(patterns) == 0 {
+		// Default: process whole package in current directory.
+		patterns = []string{"."}
+	}
+	dir := filepath.Dir(patterns[0])
+	if len(patterns) == 1 {
+		info, err := os.Stat(patterns[0])
+		if err != nil {
+			return nil, err
+		}
+		if info.IsDir() {
+			dir = patterns[0]
+		}
+	}
+
+	// Figure out filename to write to
+	outputName := cfg.Output
+	if outputName == "" {
+		baseName := fmt.Sprintf("%s_string.go", cfg.Types[0])
+		outputName = filepath.Join(dir, strings.ToLower(baseName))
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			e, ok := r.(generateError)
+			if !ok {
+				panic(r)
+			}
+			files, err = nil, e.err
+		}
+	}()
+
+	g := Generator{outputName: outputName}
+	if cfg.Templates != "" {
+		if g.templates, err = loadTemplates(cfg.Templates); err != nil {
+			return nil, err
+		}
+	}
+	if g.emits, err = loadEmitTemplates(cfg.EmitTemplates); err != nil {
+		return nil, err
+	}
+	g.parsePackage(ctx, patterns)
+
+	// Print the header and package clause.
+	args := strings.Join(cfg.Args, " ")
+	g.Printf("// Code generated by \"enumer %s\"; DO NOT EDIT.\n", args)
+	g.Printf("\n")
+	if len(cfg.Comments) > 0 {
+		g.Printf("// %s\n", strings.Join(cfg.Comments, ""))
+	}
+	g.Printf("package %s", g.pkg.name)
+	g.Printf("\n")
+	imports := []string{"fmt"}
+	if flags[AllowNumeric] {
+		imports = append(imports, "strconv")
+	}
+	if flags[IgnoreCase] || g.transformRequiresStrings(options[TransformMethod]) {
+		imports = append(imports, "strings")
+	}
+	imports = append(imports, pluginImports(flags, options)...)
+	g.Printf("import (\n")
+	imported := make(map[string]bool)
+	for _, path := range imports {
+		if !imported[path] {
+			g.Printf("\t%q\n", path)
+			imported[path] = true
+		}
+	}
+	g.Printf(")\n")
+
+	// Run generate for each type.
+	for _, typeName := range cfg.Types {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		g.generate(typeName, flags, options)
+	}
+	g.buildEmitTemplates()
+
+	// Format the output.
+	var src []byte
+	if g.templates != nil {
+		// Overridden templates may generate anything, using packages the
+		// built-in ones don't import or leaving some unused: fix the imports
+		// and make sure the code compiles before handing it out.
+		if src, err = goimports.Process(outputName, g.buf.Bytes(), nil); err != nil {
+			return nil, fmt.Errorf("templates generate invalid Go code: %s", err)
+		}
+		if err := typeCheck(ctx, patterns, outputName, src); err != nil {
+			return nil, err
+		}
+	} else if src, err = g.format(); err != nil {
+		// Should never happen, but can arise when developing this code.
+		return nil, fmt.Errorf("internal error: invalid Go generated: %s", err)
+	}
+	files = append(files, File{Name: outputName, Data: src})
+
+	// Add the side files, each with its own generated code header if its
+	// language has comments.
+	for _, f := range g.sideFiles {
+		var b bytes.Buffer
+		if f.comment != "" {
+			fmt.Fprintf(&b, "%s Code generated by \"enumer %s\"; DO NOT EDIT.\n\n", f.comment, args)
+		}
+		b.Write(f.buf.Bytes())
+		files = append(files, File{Name: f.name, Data: b.Bytes()})
+	}
+	return files, nil
+}
+
+// Generator holds the state of the analysis. Primarily used to buffer
+// the output for format.Source.
+type Generator struct {
+	buf        bytes.Buffer       // Accumulated output.
+	pkg        *Package           // Package we are scanning.
+	outputName string             // Name of the Go output file; side files are named after it.
+	sideFiles  []*sideFile        // Non-Go output files, in the order they were first written.
+	templates  *template.Template // Templates of the methods; nil for the built-in ones.
+	emits      []emitTemplate     // User templates rendered to side files.
+	enums      []*Enum            // Models of the types generated so far, see collectEmitEnum.
+	schemas    []*enumSchema      // Schemas of the types generated so far, see buildSchemas.
+	// Reset for each type being generated.
+	data *TemplateData // Data the templates are executed with.
+}
+
+// sideFile holds a non-Go output file, such as a schema fragment, that is
+// written next to the generated Go code.
+type sideFile struct {
+	name    string       // File name.
+	comment string       // Line comment marker of the file's language, used for the header; empty for no header.
+	buf     bytes.Buffer // Accumulated output.
+}
+
+// sideFileName returns name, or the default side file name for ext if name
+// is empty: the Go output file name with its extension replaced by ext.
+func (g *Generator) sideFileName(name, ext string) string {
+	if name == "" {
+		name = strings.TrimSuffix(g.outputName, ".go") + ext
+	}
+	return name
+}
+
+// sideFile returns the buffer of the named side file, creating it if it
+// doesn't exist yet. An empty name selects the default one for ext, see
+// sideFileName. Several types generated in one run share their side files.
+func (g *Generator) sideFile(name, ext, comment string) *bytes.Buffer {
+	name = g.sideFileName(name, ext)
+	for _, f := range g.sideFiles {
+		if f.name == name {
+			return &f.buf
+		}
+	}
+	f := &sideFile{name: name, comment: comment}
+	g.sideFiles = append(g.sideFiles, f)
+	return &f.buf
+}
+
+// Printf prints the string to the output
+func (g *Generator) Printf(format string, args ...interface{}) {
+	fmt.Fprintf(&g.buf, format, args...)
+}
+
+// sourceFile holds a single parsed file and associated data.
+type sourceFile struct {
+	pkg  *Package  // Package to which this file belongs.
+	file *ast.File // Parsed AST.
+	// These fields are reset for each type being generated.
+	typeName string  // Name of the constant type.
+	values   []Value // Accumulator for constant values of that type.
+}
+
+// Package holds information about a Go package
+type Package struct {
+	dir      string
+	name     string
+	path     string
+	defs     map[*ast.Ident]types.Object
+	files    []*sourceFile
+	typesPkg *types.Package
+}
+
+//// parsePackageDir parses the package residing in the directory.
+//func (g *Generator) parsePackageDir(directory string) {
+//	pkg, err := build.Default.ImportDir(directory, 0)
+//	if err != nil {
+//		log.Fatalf("cannot process directory %s: %s", directory, err)
+//	}
+//	var names []string
+//	names = append(names, pkg.GoFiles...)
+//	names = append(names, pkg.CgoFiles...)
+//	// TODO: Need to think about constants in test files. Maybe write type_string_test.go
+//	// in a separate pass? For later.
+//	// names = append(names, pkg.TestGoFiles...) // These are also in the "foo" package.
+//	names = append(names, pkg.SFiles...)
+//	names = prefixDirectory(directory, names)
+//	g.parsePackage(directory, names, nil)
+//}
+//
+//// parsePackageFiles parses the package occupying the named files.
+//func (g *Generator) parsePackageFiles(names []string) {
+//	g.parsePackage(".", names, nil)
+//}
+//
+//// prefixDirectory places the directory name on the beginning of each name in the list.
+//func prefixDirectory(directory string, names []string) []string {
+//	if directory == "." {
+//		return names
+//	}
+//	ret := make([]string, len(names))
+//	for i, name := range names {
+//		ret[i] = filepath.Join(directory, name)
+//	}
+//	return ret
+//}
+
+//// parsePackage analyzes the single package constructed from the named files.
+//// If text is non-nil, it is a string to be used instead of the content of the file,
+//// to be used for testing. parsePackage exits if there is an error.
+//func (g *Generator) parsePackage(directory string, names []string, text interface{}) {
+//	var files []*File
+//	var astFiles []*ast.File
+//	g.pkg = new(Package)
+//	fs := token.NewFileSet()
+//	for _, name := range names {
+//		if !strings.HasSuffix(name, ".go") {
+//			continue
+//		}
+//		parsedFile, err := parser.ParseFile(fs, name, text, 0)
+//		if err != nil {
+//			log.Fatalf("parsing package: %s: %s", name, err)
+//		}
+//		astFiles = append(astFiles, parsedFile)
+//		files = append(files, &File{
+//			file: parsedFile,
+//			pkg:  g.pkg,
+//		})
+//	}
+//	if len(astFiles) == 0 {
+//		log.Fatalf("%s: no buildable Go files", directory)
+//	}
+//	g.pkg.name = astFiles[0].Name.Name
+//	g.pkg.files = files
+//	g.pkg.dir = directory
+//	// Type check the package.
+//	g.pkg.check(fs, astFiles)
+//}
+
+// parsePackage analyzes the single package constructed from the patterns and tags.
+// parsePackage exits if there is an error.
+func (g *Generator) parsePackage(ctx context.Context, patterns []string) {
+	cfg := &packages.Config{
+		Context: ctx,
+		Mode:    packages.LoadSyntax,
+		// TODO: Need to think about constants in test files. Maybe write type_string_test.go
+		// in a separate pass? For later.
+		Tests: false,
+	}
+	pkgs, err := packages.Load(cfg, patterns...)
+	if err != nil {
+		failf("%s", err)
+	}
+	if len(pkgs) != 1 {
+		failf("%d packages found", len(pkgs))
+	}
+	g.addPackage(pkgs[0])
+}
+
+// addPackage adds a type checked Package and its syntax files to the generator.
+func (g *Generator) addPackage(pkg *packages.Package) {
+	g.pkg = &Package{
+		name:  pkg.Name,
+		path:  pkg.PkgPath,
+		defs:  pkg.TypesInfo.Defs,
+		files: make([]*sourceFile, len(pkg.Syntax)),
+	}
+
+	for i, file := range pkg.Syntax {
+		g.pkg.files[i] = &sourceFile{
+			file: file,
+			pkg:  g.pkg,
+		}
+	}
+}
+
+// check type-checks the package. The package must be OK to proceed.
+func (pkg *Package) check(fs *token.FileSet, astFiles []*ast.File) {
+	pkg.defs = make(map[*ast.Ident]types.Object)
+	config := types.Config{Importer: importer.Default(), FakeImportC: true}
+	info := &types.Info{
+		Defs: pkg.defs,
+	}
+	typesPkg, err := config.Check(pkg.dir, fs, astFiles, info)
+	if err != nil {
+		failf("checking package: %s", err)
+	}
+	pkg.typesPkg = typesPkg
+}
+
+func (g *Generator) transformRequiresStrings(TransformMethod string) bool {
+	switch TransformMethod {
+	case ToLower:
+	case ToUpper:
+	case ToJSON:
+	case ToSnakeUpper:
+	case ToKebabUpper:
+		return true
+	}
+	return false
+}
+
+func (g *Generator) transformValueNames(values []Value, TransformMethod string, empty string) {
+	var sep rune
+	var upper bool
+	var json bool
+	transform := true
+	switch TransformMethod {
+	case ToLower:
+		upper = false
+	case ToUpper:
+		upper = true
+	case ToJSON:
+		json = true
+	case ToSnake:
+		sep = '_'
+	case ToSnakeUpper:
+		sep = '_'
+		upper = true
+	case ToKebab:
+		sep = '-'
+	case ToKebabUpper:
+		sep = '-'
+		upper = true
+	default:
+		transform = false
+	}
+
+	for i := range values {
+		s := values[i].name
+		if transform {
+			if json {
+				values[i].name = jsonCase(s)
+			} else {
+				if sep != 0 {
+					s = name.Delimit(s, sep)
+				}
+				if upper {
+					values[i].name = strings.ToUpper(s)
+				} else {
+					values[i].name = strings.ToLower(s)
+				}
+			}
+		}
+		if values[i].name == empty {
+			values[i].name = ""
+		}
+	}
+}
+
+// jsonCase converts a Go-style name to JSON (lower camel) case. The word
+// boundaries match the ones name.Delimit uses for the snake and kebab
+// transforms: a word starts at an uppercase letter that follows a lowercase
+// letter, or at an uppercase letter that begins a word (is followed by a
+// lowercase letter) after an uppercase letter or a digit. Within a run of
+// uppercase letters the last letter therefore starts the next word and the
+// letters before it form an initialism: "MyBBGun" splits into "My", "BB",
+// "Gun". The first word is lowercased entirely and each later word keeps
+// only its leading capital, so "MyBBGun" becomes "myBbGun", "AWSAccessKey"
+// becomes "awsAccessKey", and "IAMUsers" becomes "iamUsers".
+func jsonCase(s string) string {
+	runes := []rune(s)
+	var b strings.Builder
+	b.Grow(len(s))
+	word := 0 // index of the current word's first rune
+	for i, r := range runes {
+		if i > 0 && unicode.IsUpper(r) {
+			prev := runes[i-1]
+			nextIsLower := i+1 < len(runes) && unicode.IsLower(runes[i+1])
+			if unicode.IsLower(prev) ||
+				((unicode.IsUpper(prev) || unicode.IsDigit(prev)) && nextIsLower) {
+				word = i
+			}
+		}
+		if word == 0 || i > word {
+			// The first word is lowercased entirely; later words keep
+			// only their leading capital.
+			r = unicode.ToLower(r)
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// trimValueNames removes a prefix from each name
+func (g *Generator) trimValueNames(values []Value, prefix string) {
+	for i := range values {
+		values[i].name = strings.TrimPrefix(values[i].name, prefix)
+	}
+}
+
+func (g *Generator) replaceValuesWithLineComment(values []Value) {
+	for i, val := range values {
+		if val.comment != "" {
+			values[i].name = val.comment
+		}
+	}
+}
+
+// generate produces the String method for the named type.
+func (g *Generator) generate(typeName string, flags map[string]bool, options map[string]string) {
+	values := make([]Value, 0, 100)
+	for _, file := range g.pkg.files {
+		// Set the state for this run of the walker.
+		file.typeName = typeName
+		file.values = nil
+		if file.file != nil {
+			ast.Inspect(file.file, file.genDecl)
+			values = append(values, file.values...)
+		}
+	}
+
+	if len(values) == 0 {
+		failf("no values defined for type %s", typeName)
+	}
+
+	g.trimValueNames(values, options[TrimPrefix])
+
+	g.transformValueNames(values, options[TransformMethod], options[EmptyValue])
+
+	if flags[LineComment] {
+		g.replaceValuesWithLineComment(values)
+	}
+
+	runs := splitIntoRuns(values)
+	g.data = g.templateData(runs, typeName, flags, options)
+	// The decision of which pattern to use depends on the number of
+	// runs in the numbers. If there's only one, it's easy. For more than
+	// one, there's a tradeoff between complexity and size of the data
+	// and code vs. the simplicity of a map. A map takes more space,
+	// but so does the code. The decision here (crossover at 10) is
+	// arbitrary, but considers that for large numbers of runs the cost
+	// of the linear scan in the switch might become important, and
+	// rather than use yet another algorithm such as binary search,
+	// we punt and use a map. In any case, the likelihood of a map
+	// being necessary for any realistic example other than bitmasks
+	// is very low. And bitmasks probably deserve their own analysis,
+	// to be done some other day.
+	const runsThreshold = 10
+	switch {
+	case len(runs) == 1:
+		g.buildOneRun(runs, typeName)
+	case len(runs) <= runsThreshold:
+		g.buildMultipleRuns(runs, typeName)
+	default:
+		g.buildMap(runs, typeName)
+	}
+
+	g.buildBasicExtras(runs, typeName, runsThreshold, caseMatch(flags[IgnoreCase], options[TransformMethod]), flags[ValuesCopy])
+
+	// The built-in formats of the Go code are plugins, run like the
+	// registered ones; the side files are written by the generator itself.
+	g.buildPlugins(runs, typeName, flags, options, true)
+	if flags[GraphQLSchema] {
+		g.buildGraphQLSchema(runs, typeName, options[GraphQLSchema])
+	}
+	if shells, ok := options[EmitShells]; ok {
+		g.buildCompletionScripts(runs, typeName, strings.Split(shells, ","))
+	}
+	if dialect, ok := options[DDLDialect]; ok {
+		g.buildDDL(runs, typeName, dialect, options[DDLOutput], options[DDLPrevious])
+	}
+	if flags[IncludeTS] {
+		g.buildTypeScript(runs, typeName, options[TSStyle], options[IncludeTS], flags[AllowNumeric])
+	}
+	if flags[IncludeProto] {
+		protoPackage := options[ProtoPackage]
+		if protoPackage == "" {
+			protoPackage = g.pkg.name
+		}
+		g.buildProto(runs, typeName, options[TrimPrefix], options[IncludeProto], protoPackage)
+	}
+	if flags[IncludeJSONSchema] || flags[IncludeOpenAPI] {
+		g.buildSchemas(runs, typeName, flags, options)
+	}
+	g.collectEmitEnum(runs, typeName)
+	g.buildPlugins(runs, typeName, flags, options, false)
+}
+
+// splitIntoRuns breaks the values into runs of contiguous sequences.
+// For example, given 1,2,3,5,6,7 it returns {1,2,3},{5,6,7}.
+// The input slice is known to be non-empty.
+func splitIntoRuns(values []Value) [][]Value {
+	// We use stable sort so the lexically first name is chosen for equal elements.
+	sort.Stable(byValue(values))
+	// Remove duplicates. Stable sort has put the one we want to print first,
+	// so use that one. The String method won't care about which named constant
+	// was the argument, so the first name for the given value is the only one to keep.
+	// We need to do this because identical values would cause the switch or map
+	// to fail to compile.
+	j := 1
+	for i := 1; i < len(values); i++ {
+		if values[i].value != values[i-1].value {
+			values[j] = values[i]
+			j++
+		}
+	}
+	values = values[:j]
+	runs := make([][]Value, 0, 10)
+	for len(values) > 0 {
+		// One contiguous sequence per outer loop.
+		i := 1
+		for i < len(values) && values[i].value == values[i-1].value+1 {
+			i++
+		}
+		runs = append(runs, values[:i])
+		values = values[i:]
+	}
+	return runs
+}
+
+// format returns the gofmt-ed contents of the Generator's buffer.
+func (g *Generator) format() ([]byte, error) {
+	return format.Source(g.buf.Bytes())
+}
+
+// Value represents a declared constant.
+type Value struct {
+	name   string // The name of the constant after transformation (i.e. camel case => snake case)
+	goName string // The name of the constant in the Go source
+	// The value is stored as a bit pattern alone. The boolean tells us
+	// whether to interpret it as an int64 or a uint64; the only place
+	// this matters is when sorting.
+	// Much of the time the str field is all we need; it is printed
+	// by Value.String.
+	value   uint64 // Will be converted to int64 when needed.
+	signed  bool   // Whether the constant is a signed type.
+	str     string // The string representation given by the "go/exact" package.
+	comment string // The comment on the right of the constant
+	doc     string // The doc comment above the constant
+}
+
+func (v *Value) String() string {
+	return v.str
+}
+
+// byValue lets us sort the constants into increasing order.
+// We take care in the Less method to sort in signed or unsigned order,
+// as appropriate.
+type byValue []Value
+
+func (b byValue) Len() int      { return len(b) }
+func (b byValue) Swap(i, j int) { b[i], b[j] = b[j], b[i] }
+func (b byValue) Less(i, j int) bool {
+	if b[i].signed {
+		return int64(b[i].value) < int64(b[j].value)
+	}
+	return b[i].value < b[j].value
+}
+
+// genDecl processes one declaration clause.
+func (f *sourceFile) genDecl(node ast.Node) bool {
+	decl, ok := node.(*ast.GenDecl)
+	if !ok || decl.Tok != token.CONST {
+		// We only care about const declarations.
+		return true
+	}
+	// The name of the type of the constants we are declaring.
+	// Can change if this is a multi-element declaration.
+	typ := ""
+	// Loop over the elements of the declaration. Each element is a ValueSpec:
+	// a list of names possibly followed by a type, possibly followed by values.
+	// If the type and value are both missing, we carry down the type (and value,
+	// but the "go/types" package takes care of that).
+	for _, spec := range decl.Specs {
+		vspec := spec.(*ast.ValueSpec) // Guaranteed to succeed as this is CONST.
+		if vspec.Type == nil && len(vspec.Values) > 0 {
+			// "X = 1". With no type but a value, the constant is untyped.
+			// Skip this vspec and reset the remembered type.
+			typ = ""
+			continue
+		}
+		if vspec.Type != nil {
+			// "X T". We have a type. Remember it.
+			ident, ok := vspec.Type.(*ast.Ident)
+			if !ok {
+				continue
+			}
+			typ = ident.Name
+		}
+		if typ != f.typeName {
+			// This is not the type we're looking for.
+			continue
+		}
+		// We now have a list of names (from one line of source code) all being
+		// declared with the desired type.
+		// Grab their names and actual values and store them in f.values.
+		for _, name := range vspec.Names {
+			if name.Name == "_" {
+				continue
+			}
+			// This dance lets the type checker find the values for us. It's a
+			// bit tricky: look up the object declared by the name, find its
+			// types.Const, and extract its value.
+			obj, ok := f.pkg.defs[name]
+			if !ok {
+				failf("no value for constant %s", name)
+			}
+			info := obj.Type().Underlying().(*types.Basic).Info()
+			if info&types.IsInteger == 0 {
+				failf("can't handle non-integer constant type %s", typ)
+			}
+			value := obj.(*types.Const).Val() // Guaranteed to succeed as this is CONST.
+			if value.Kind() != exact.Int {
+				failf("can't happen: constant is not an integer %s", name)
+			}
+			i64, isInt := exact.Int64Val(value)
+			u64, isUint := exact.Uint64Val(value)
+			if !isInt && !isUint {
+				failf("internal error: value of %s is not an integer: %s", name, value.String())
+			}
+			if !isInt {
+				u64 = uint64(i64)
+			}
+			comment := ""
+			if c := vspec.Comment; c != nil && len(c.List) == 1 {
+				comment = strings.TrimSpace(c.Text())
+			}
+			doc := ""
+			if d := vspec.Doc; d != nil {
+				doc = strings.TrimSpace(d.Text())
+			} else if d := decl.Doc; d != nil && !decl.Lparen.IsValid() {
+				// "// Doc\nconst X T = 1". The doc belongs to the declaration.
+				doc = strings.TrimSpace(d.Text())
+			}
+
+			v := Value{
+				name:    name.Name,
+				goName:  name.Name,
+				value:   u64,
+				signed:  info&types.IsUnsigned == 0,
+				str:     value.String(),
+				comment: comment,
+				doc:     doc,
+			}
+			f.values = append(f.values, v)
+		}
+	}
+	return false
+}
+
+// Helpers
+
+// usize returns the number of bits of the smallest unsigned integer
+// type that will hold n. Used to create the smallest possible slice of
+// integers to use as indexes into the concatenated strings.
+func usize(n int) int {
+	switch {
+	case n < 1<<8:
+		return 8
+	case n < 1<<16:
+		return 16
+	default:
+		// 2^32 is enough constants for anyone.
+		return 32
+	}
+}
+
+// declareIndexAndNameVars declares the index slices and concatenated names
+// strings representing the runs of values.
+func (g *Generator) declareIndexAndNameVars(runs [][]Value, typeName string) {
+	var indexes, names []string
+	for i, run := range runs {
+		index, name := g.createIndexAndNameDecl(run, typeName, fmt.Sprintf("_%d", i))
+		indexes = append(indexes, index)
+		names = append(names, name)
+	}
+	g.Printf("const (\n")
+	for _, name := range names {
+		g.Printf("\t%s\n", name)
+	}
+	g.Printf(")\n\n")
+	g.Printf("var (")
+	for _, index := range indexes {
+		g.Printf("\t%s\n", index)
+	}
+	g.Printf(")\n\n")
+}
+
+// declareIndexAndNameVar is the single-run version of declareIndexAndNameVars
+func (g *Generator) declareIndexAndNameVar(run []Value, typeName string) {
+	index, name := g.createIndexAndNameDecl(run, typeName, "")
+	g.Printf("const %s\n", name)
+	g.Printf("var %s\n", index)
+}
+
+// createIndexAndNameDecl returns the pair of declarations for the run. The caller will add "const" and "var".
+func (g *Generator) createIndexAndNameDecl(run []Value, typeName string, suffix string) (string, string) {
+	b := new(bytes.Buffer)
+	indexes := make([]int, len(run))
+	for i := range run {
+		b.WriteString(run[i].name)
+		indexes[i] = b.Len()
+	}
+	nameConst := fmt.Sprintf("_%sName%s = %q", typeName, suffix, b.String())
+	nameLen := b.Len()
+	b.Reset()
+	fmt.Fprintf(b, "_%sIndex%s = [...]uint%d{0, ", typeName, suffix, usize(nameLen))
+	for i, v := range indexes {
+		if i > 0 {
+			fmt.Fprintf(b, ", ")
+		}
+		fmt.Fprintf(b, "%d", v)
+	}
+	fmt.Fprintf(b, "}")
+	return b.String(), nameConst
+}
+
+// declareNameVars declares the concatenated names string representing all the values in the runs.
+func (g *Generator) declareNameVars(runs [][]Value, typeName string, suffix string) {
+	g.Printf("const _%sName%s = \"", typeName, suffix)
+	for _, run := range runs {
+		for i := range run {
+			g.Printf("%s", run[i].name)
+		}
+	}
+	g.Printf("\"\n")
+}
+
+// buildOneRun generates the variables and String method for a single run of contiguous values.
+func (g *Generator) buildOneRun(runs [][]Value, typeName string) {
+	values := runs[0]
+	g.Printf("\n")
+	g.declareIndexAndNameVar(values, typeName)
+	// The generated code is simple enough to write as a template.
+	if values[0].value == 0 { // Signed or unsigned, 0 is still 0.
+		g.execute("stringOneRun")
+	} else {
+		g.execute("stringOneRunWithOffset")
+	}
+}
+
+const stringOneRun = `func (i {{.TypeName}}) String() string {
+	if {{if .Signed}}i < 0 || {{end}}i >= {{.TypeName}}(len(_{{.TypeName}}Index)-1) {
+		return fmt.Sprintf("{{.TypeName}}(%d)", i)
+	}
+	return _{{.TypeName}}Name[_{{.TypeName}}Index[i]:_{{.TypeName}}Index[i+1]]
+}
+`
+
+/*
+ */
+const stringOneRunWithOffset = `func (i {{.TypeName}}) String() string {
+	i -= {{.Offset}}
+	if {{if .Signed}}i < 0 || {{end}}i >= {{.TypeName}}(len(_{{.TypeName}}Index)-1) {
+		return fmt.Sprintf("{{.TypeName}}(%d)", i + {{.Offset}})
+	}
+	return _{{.TypeName}}Name[_{{.TypeName}}Index[i] : _{{.TypeName}}Index[i+1]]
+}
+`
+
+// buildMultipleRuns generates the variables and String method for multiple runs of contiguous values.
+func (g *Generator) buildMultipleRuns(runs [][]Value, typeName string) {
+	g.Printf("\n")
+	g.declareIndexAndNameVars(runs, typeName)
+	g.execute("stringMultipleRuns")
+}
+
+const stringMultipleRuns = `func (i {{.TypeName}}) String() string {
+	switch {
+{{- range $n, $run := .Runs}}
+{{- if eq .First .Last}}
+	case i == {{.First}}:
+		return _{{$.TypeName}}Name_{{$n}}
+{{- else}}
+	case {{.First}} <= i && i <= {{.Last}}:
+{{- if ne .First "0"}}
+		i -= {{.First}}
+{{- end}}
+		return _{{$.TypeName}}Name_{{$n}}[_{{$.TypeName}}Index_{{$n}}[i]:_{{$.TypeName}}Index_{{$n}}[i+1]]
+{{- end}}
+{{- end}}
+	default:
+		return fmt.Sprintf("{{.TypeName}}(%d)", i)
+	}
+}
+`
+
+// buildMap handles the case where the space is so sparse a map is a reasonable fallback.
+// It's a rare situation but has simple code.
+func (g *Generator) buildMap(runs [][]Value, typeName string) {
+	g.Printf("\n")
+	g.declareNameVars(runs, typeName, "")
+	g.execute("stringMap")
+}
+
+const stringMap = `
+var _{{.TypeName}}Map = map[{{.TypeName}}]string{
+{{- range .Values}}
+	{{.Literal}}: _{{$.TypeName}}Name[{{.NameStart}}:{{.NameEnd}}],
+{{- end}}
+}
+
+func (i {{.TypeName}}) String() string {
+	if str, ok := _{{.TypeName}}Map[i]; ok {
+		return str
+	}
+	return fmt.Sprintf("{{.TypeName}}(%d)", i)
+}
+`
+
+// templateData returns the data the templates are executed with for the type.
+func (g *Generator) templateData(runs [][]Value, typeName string, flags map[string]bool, options map[string]string) *TemplateData {
+	first := runs[0][0]
+	number := "int64"
+	if !first.signed {
+		number = "uint64"
+	}
+	separator := options[ListSeparator]
+	if separator == "" {
+		separator = ","
+	}
+	transform := options[TransformMethod]
+	if transform == "" {
+		transform = "noop"
+	}
+	var graphQLNames []string
+	if flags[IncludeGraphQL] || flags[GraphQLSchema] {
+		graphQLNames = g.graphQLNames(runs, options[TrimPrefix], options[GraphQLCase])
+	}
+	var names []string
+	var values []TemplateValue
+	var templateRuns []TemplateRun
+	offset := 0
+	for _, run := range runs {
+		for _, value := range run {
+			v := TemplateValue{EnumValue: newEnumValue(value), NameStart: offset, NameEnd: offset + len(value.name)}
+			if graphQLNames != nil {
+				v.GraphQLName = graphQLNames[len(values)]
+			}
+			names = append(names, value.name)
+			values = append(values, v)
+			offset = v.NameEnd
+		}
+		templateRuns = append(templateRuns, TemplateRun{First: run[0].String(), Last: run[len(run)-1].String()})
+	}
+	return &TemplateData{
+		TypeName:    typeName,
+		Package:     g.pkg.name,
+		Signed:      first.signed,
+		NumberType:  number,
+		Offset:      first.String(),
+		FirstGoName: first.goName,
+		Numeric:     flags[AllowNumeric],
+		IntStorage:  options[SQLStorage] == SQLInt,
+		Separator:   separator,
+		Dedupe:      flags[ListDedupe],
+		SQLName:     strings.ToLower(name.Delimit(typeName, '_')),
+		SQLValues:   sqlList(names),
+		ProtoPrefix: protoPrefix(typeName),
+		PackagePath: g.pkg.path,
+		Transform:   transform,
+		Values:      values,
+		Runs:        templateRuns,
+	}
+}
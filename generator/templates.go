@@ -0,0 +1,227 @@
+package generator
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"text/template"
+
+	"golang.org/x/tools/go/packages"
+)
+
+// TemplateData is the data model the templates of the generated methods are
+// executed with. Every field is set, whatever the options of the run.
+type TemplateData struct {
+	TypeName    string // Name of the enum type, such as Day
+	Package     string // Name of the package that declares the type
+	Signed      bool   // Whether the type is a signed integer type
+	NumberType  string // Go type the values convert to: int64 for signed types, uint64 for unsigned ones
+	Offset      string // Lowest value of the enum, as a Go literal
+	FirstGoName string // Go name of the lowest value of the enum
+	Numeric     bool   // Whether numbers are accepted where names are expected, see -numeric
+	IntStorage  bool   // Whether SQL stores the values as integers, see -sql=int
+	Separator   string // Separator of the values of a <Type>List, see -listsep
+	Dedupe      bool   // Whether <Type>List drops repeated values, see -listdedupe
+	SQLName     string // Name of the type in snake case, used as the name of the SQL enum type
+	SQLValues   string // Comma-separated list of the SQL string literals of the values
+	ProtoPrefix string // Prefix of the names of the proto enum values, such as DAY_
+	PackagePath string // Import path of the package, see -register
+	Transform   string // Transformation of the names, see -transform; noop for none
+
+	Values []TemplateValue // Values of the enum, sorted by value, without duplicates
+	Runs   []TemplateRun   // Bounds of the runs of consecutive values, see stringMultipleRuns
+}
+
+// TemplateValue is a value of the enum, as the templates see it.
+type TemplateValue struct {
+	EnumValue
+	GraphQLName string // Name of the value in GraphQL, with -graphql or -graphqlschema
+	NameStart   int    // Start of Name in the concatenated names of all the values, see stringMap
+	NameEnd     int    // End of Name in the concatenated names of all the values
+}
+
+// TemplateRun is a run of consecutive values of the enum.
+type TemplateRun struct {
+	First string // Lowest value of the run, as a Go literal
+	Last  string // Highest value of the run, as a Go literal
+}
+
+// templateTexts holds the text of every template of the generated methods,
+// by name. Each of them can be overridden with a <name>.tmpl file in the
+// directory of Options.Templates.
+var templateTexts = map[string]string{
+	"stringNameToValueMethod":           stringNameToValueMethod,
+	"stringIgnoreCaseNameToValueMethod": stringIgnoreCaseNameToValueMethod,
+	"stringUpperNameToValueMethod":      stringUpperNameToValueMethod,
+	"stringLowerNameToValueMethod":      stringLowerNameToValueMethod,
+	"stringNumericCheck":                stringNumericCheck,
+	"stringValuesMethod":                stringValuesMethod,
+	"stringValuesCopyMethod":            stringValuesCopyMethod,
+	"stringBelongsMethodLoop":           stringBelongsMethodLoop,
+	"stringBelongsMethodSet":            stringBelongsMethodSet,
+	"stringOneRun":                      stringOneRun,
+	"stringOneRunWithOffset":            stringOneRunWithOffset,
+	"stringMultipleRuns":                stringMultipleRuns,
+	"stringMap":                         stringMap,
+	"jsonMethods":                       jsonMethods,
+	"jsonNumericCheck":                  jsonNumericCheck,
+	"jsonNoNumericCheck":                jsonNoNumericCheck,
+	"textMethods":                       textMethods,
+	"yamlMethods":                       yamlMethods,
+	"yamlV3Methods":                     yamlV3Methods,
+	"yamlV3NumericCheck":                yamlV3NumericCheck,
+	"xmlMethods":                        xmlMethods,
+	"binaryVarintMethods":               binaryVarintMethods,
+	"binaryNameMethods":                 binaryNameMethods,
+	"flagMethods":                       flagMethods,
+	"listType":                          listType,
+	"listDedupeCheck":                   listDedupeCheck,
+	"slogMethod":                        slogMethod,
+	"slogGroupMethod":                   slogGroupMethod,
+	"formatMethods":                     formatMethods,
+	"iterFuncs":                         iterFuncs,
+	"enumMethods":                       enumMethods,
+	"registerFunc":                      registerFunc,
+	"infoMethods":                       infoMethods,
+	"completionsFunc":                   completionsFunc,
+	"protoFuncs":                        protoFuncs,
+	"valueMethod":                       valueMethod,
+	"valueIntMethod":                    valueIntMethod,
+	"scanMethod":                        scanMethod,
+	"scanIntStringCheck":                scanIntStringCheck,
+	"pgArrayType":                       pgArrayType,
+	"pgNullArrayType":                   pgNullArrayType,
+	"nullType":                          nullType,
+	"nullSQLMethods":                    nullSQLMethods,
+	"nullJSONMethods":                   nullJSONMethods,
+	"nullTextMethods":                   nullTextMethods,
+	"nullYAMLMarshalMethod":             nullYAMLMarshalMethod,
+	"nullYAMLUnmarshalMethod":           nullYAMLUnmarshalMethod,
+	"nullYAMLV3UnmarshalMethod":         nullYAMLV3UnmarshalMethod,
+	"nullXMLMethods":                    nullXMLMethods,
+	"nullBinaryMethods":                 nullBinaryMethods,
+	"nullGraphQLMethods":                nullGraphQLMethods,
+	"nullFlagMethods":                   nullFlagMethods,
+	"graphQLMethods":                    graphQLMethods,
+	"entValuesMethod":                   entValuesMethod,
+	"gormDataTypeMethod":                gormDataTypeMethod,
+	"gormDBDataTypeMethod":              gormDBDataTypeMethod,
+}
+
+// builtinTemplates is the set of the built-in templates.
+var builtinTemplates = func() *template.Template {
+	set := template.New("enumer")
+	for name, text := range templateTexts {
+		template.Must(set.New(name).Parse(text))
+	}
+	return set
+}()
+
+// TemplateNames returns the names of the templates that can be overridden,
+// sorted.
+func TemplateNames() []string {
+	names := make([]string, 0, len(templateTexts))
+	for name := range templateTexts {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// loadTemplates returns the built-in templates, with those defined by the
+// <name>.tmpl files of dir replacing the ones of the same name.
+func loadTemplates(dir string) (*template.Template, error) {
+	paths, err := filepath.Glob(filepath.Join(dir, "*.tmpl"))
+	if err != nil {
+		return nil, err
+	}
+	if len(paths) == 0 {
+		return nil, fmt.Errorf("%s: no template files", dir)
+	}
+	set, err := builtinTemplates.Clone()
+	if err != nil {
+		return nil, err
+	}
+	for _, path := range paths {
+		name := strings.TrimSuffix(filepath.Base(path), ".tmpl")
+		if _, ok := templateTexts[name]; !ok {
+			return nil, fmt.Errorf("%s: unknown template %s", path, name)
+		}
+		text, err := ioutil.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := set.New(name).Parse(string(text)); err != nil {
+			return nil, err
+		}
+	}
+	return set, nil
+}
+
+// execute prints the named template, executed with the data of the type
+// being generated.
+func (g *Generator) execute(name string) {
+	if err := g.executeTo(&g.buf, name); err != nil {
+		failf("%s", err)
+	}
+}
+
+// executeTo executes the named template like execute, but writes the code to
+// w and returns the error.
+func (g *Generator) executeTo(w io.Writer, name string) error {
+	set := g.templates
+	if set == nil {
+		set = builtinTemplates
+	}
+	var b bytes.Buffer
+	if err := set.ExecuteTemplate(&b, name, g.data); err != nil {
+		return fmt.Errorf("executing template: %s", err)
+	}
+	_, err := w.Write(b.Bytes())
+	return err
+}
+
+// typeCheck type-checks the package of the patterns with src as the contents
+// of the Go output file.
+func typeCheck(ctx context.Context, patterns []string, outputName string, src []byte) error {
+	output, err := filepath.Abs(outputName)
+	if err != nil {
+		return err
+	}
+	if info, err := os.Stat(patterns[0]); err != nil || len(patterns) > 1 || !info.IsDir() {
+		// The files of the package are listed; the output file is one of
+		// them, even if it doesn't exist yet.
+		files := []string{output}
+		for _, p := range patterns {
+			if abs, err := filepath.Abs(p); err != nil || abs != output {
+				files = append(files, p)
+			}
+		}
+		patterns = files
+	}
+	cfg := &packages.Config{
+		Context: ctx,
+		Mode:    packages.LoadSyntax,
+		Overlay: map[string][]byte{output: src},
+	}
+	pkgs, err := packages.Load(cfg, patterns...)
+	if err != nil {
+		return err
+	}
+	var errs []string
+	for _, pkg := range pkgs {
+		for _, e := range pkg.Errors {
+			errs = append(errs, e.Error())
+		}
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("templates generate invalid Go code:\n%s", strings.Join(errs, "\n"))
+	}
+	return nil
+}
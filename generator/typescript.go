@@ -0,0 +1,132 @@
+package generator
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+const (
+	TSUnion  = "union"
+	TSObject = "object"
+)
+
+// buildTypeScript writes the TypeScript declaration of the enum to the
+// TypeScript side file: a union of the names MarshalJSON produces, or an
+// "as const" object of them keyed by the Go names, and an is<Type> type
+// guard. If numeric is set, UnmarshalJSON accepts the numbers of the values
+// too, so a <Type>Numbers object mapping the names to them, a <Type>Number
+// type and an is<Type>Number type guard are written as well. The numbers that
+// a JavaScript number can't hold exactly are written as strings of their
+// digits, which UnmarshalJSON accepts as well. Doc comments, or else line
+// comments, become TSDoc comments.
+func (g *Generator) buildTypeScript(runs [][]Value, typeName, style, fileName string, numeric bool) {
+	b := g.sideFile(fileName, ".ts", "//")
+	if b.Len() > 0 {
+		b.WriteString("\n")
+	}
+	var values []Value
+	for _, run := range runs {
+		values = append(values, run...)
+	}
+
+	fmt.Fprintf(b, "/** Values of the Go enum %s.%s, as encoded in JSON. */\n", g.pkg.name, typeName)
+	if style == TSObject {
+		fmt.Fprintf(b, "export const %s = {\n", typeName)
+		for _, v := range values {
+			writeTSDoc(b, "  ", v)
+			fmt.Fprintf(b, "  %s: %s,\n", v.goName, strconv.Quote(v.name))
+		}
+		b.WriteString("} as const;\n\n")
+		fmt.Fprintf(b, "export type %[1]s = (typeof %[1]s)[keyof typeof %[1]s];\n\n", typeName)
+		fmt.Fprintf(b, "/** Reports whether v is a %s. */\n", typeName)
+		fmt.Fprintf(b, "export function is%[1]s(v: unknown): v is %[1]s {\n", typeName)
+		fmt.Fprintf(b, "  return (Object.values(%s) as unknown[]).includes(v);\n", typeName)
+		b.WriteString("}\n")
+	} else {
+		fmt.Fprintf(b, "export type %s =\n", typeName)
+		for i, v := range values {
+			writeTSDoc(b, "  ", v)
+			fmt.Fprintf(b, "  | %s", strconv.Quote(v.name))
+			if i == len(values)-1 {
+				b.WriteString(";")
+			}
+			b.WriteString("\n")
+		}
+		b.WriteString("\n")
+		fmt.Fprintf(b, "/** Every %s, in the order of their numbers. */\n", typeName)
+		fmt.Fprintf(b, "export const %[1]sValues: readonly %[1]s[] = [\n", typeName)
+		for _, v := range values {
+			fmt.Fprintf(b, "  %s,\n", strconv.Quote(v.name))
+		}
+		b.WriteString("];\n\n")
+		fmt.Fprintf(b, "/** Reports whether v is a %s. */\n", typeName)
+		fmt.Fprintf(b, "export function is%[1]s(v: unknown): v is %[1]s {\n", typeName)
+		fmt.Fprintf(b, "  return (%sValues as readonly unknown[]).includes(v);\n", typeName)
+		b.WriteString("}\n")
+	}
+
+	if !numeric {
+		return
+	}
+	fmt.Fprintf(b, "\n/**\n")
+	fmt.Fprintf(b, " * Numbers of the %s values, which JSON accepts in place of the names, as\n", typeName)
+	fmt.Fprintf(b, " * numbers or as strings of their digits. The numbers beyond\n")
+	fmt.Fprintf(b, " * Number.MAX_SAFE_INTEGER are given as strings, which keep them exact.\n")
+	fmt.Fprintf(b, " */\n")
+	fmt.Fprintf(b, "export const %sNumbers = {\n", typeName)
+	for _, v := range values {
+		if isSafeInteger(v) {
+			fmt.Fprintf(b, "  %s: %s,\n", strconv.Quote(v.name), v.String())
+		} else {
+			fmt.Fprintf(b, "  %s: %s,\n", strconv.Quote(v.name), strconv.Quote(v.String()))
+		}
+	}
+	b.WriteString("} as const;\n\n")
+	fmt.Fprintf(b, "export type %[1]sNumber = (typeof %[1]sNumbers)[keyof typeof %[1]sNumbers];\n\n", typeName)
+	fmt.Fprintf(b, "/** Reports whether v is the number of a %s. */\n", typeName)
+	fmt.Fprintf(b, "export function is%[1]sNumber(v: unknown): v is %[1]sNumber {\n", typeName)
+	fmt.Fprintf(b, "  return (Object.values(%sNumbers) as unknown[]).includes(v);\n", typeName)
+	b.WriteString("}\n")
+}
+
+// maxSafeInteger is Number.MAX_SAFE_INTEGER, the largest integer that a
+// JavaScript number holds exactly, as do all the integers closer to zero.
+const maxSafeInteger = 1<<53 - 1
+
+// isSafeInteger reports whether a JavaScript number holds the value exactly.
+func isSafeInteger(v Value) bool {
+	if v.signed {
+		n := int64(v.value)
+		return -maxSafeInteger <= n && n <= maxSafeInteger
+	}
+	return v.value <= maxSafeInteger
+}
+
+// writeTSDoc writes the doc comment of the value, or else its line comment,
+// as a TSDoc comment indented with indent. Line comments that are the name
+// of the value, see -linecomment, are skipped.
+func writeTSDoc(b *bytes.Buffer, indent string, v Value) {
+	text := v.doc
+	if text == "" && v.comment != v.name {
+		text = v.comment
+	}
+	if text == "" {
+		return
+	}
+	lines := strings.Split(strings.Replace(text, "*/", "*\\/", -1), "\n")
+	if len(lines) == 1 {
+		fmt.Fprintf(b, "%s/** %s */\n", indent, lines[0])
+		return
+	}
+	fmt.Fprintf(b, "%s/**\n", indent)
+	for _, line := range lines {
+		if line == "" {
+			fmt.Fprintf(b, "%s *\n", indent)
+		} else {
+			fmt.Fprintf(b, "%s * %s\n", indent, line)
+		}
+	}
+	fmt.Fprintf(b, "%s */\n", indent)
+}
@@ -4,7 +4,7 @@
 
 // This file contains tests for some of the internal functions.
 
-package main
+package generator
 
 import (
 	"fmt"
@@ -54,7 +54,7 @@ Outer:
 	for n, test := range splitTests {
 		values := make([]Value, len(test.input))
 		for i, v := range test.input {
-			values[i] = Value{"", v, test.signed, fmt.Sprint(v), ""}
+			values[i] = Value{value: v, signed: test.signed, str: fmt.Sprint(v)}
 		}
 		runs := splitIntoRuns(values)
 		if len(runs) != len(test.output) {
@@ -120,3 +120,44 @@ func TestJSONCase(t *testing.T) {
 		}
 	}
 }
+
+var caseInsensitivePatternTests = []struct {
+	input  string
+	match  CaseMatch
+	output string
+}{
+	{"info", CaseLower, "[Iiİ][Nn][Ff][Oo]"},
+	{"INFO", CaseUpper, "[Iiı][Nn][Ff][Oo]"},
+	{"Info", CaseMixed, "[Ii][Nn][Ff][Oo]"},
+	// The Kelvin sign is lower-cased to k, and folds to it.
+	{"kß", CaseLower, "[Kk\u212a][ßẞ]"},
+	{"Kß", CaseUpper, "[Kk]ß"},
+	{"a-b.c", CaseMixed, `[Aa]-[Bb]\.[Cc]`},
+}
+
+func TestCaseInsensitivePattern(t *testing.T) {
+	for _, test := range caseInsensitivePatternTests {
+		if got, ok := caseInsensitivePattern(test.input, test.match); !ok || got != test.output {
+			t.Errorf("caseInsensitivePattern(%q, %d) = %q, %v; want %q", test.input, test.match, got, ok, test.output)
+		}
+	}
+	// No string has the lower case "Info".
+	if got, ok := caseInsensitivePattern("Info", CaseLower); ok {
+		t.Errorf("caseInsensitivePattern(Info, CaseLower) = %q; want no pattern", got)
+	}
+}
+
+func TestNumberPattern(t *testing.T) {
+	for _, test := range []struct {
+		value  Value
+		output string
+	}{
+		{Value{value: 0}, `[+-]?0+`},
+		{Value{value: 42}, `\+?0*42`},
+		{Value{value: ^uint64(0), signed: true}, `-0*1`},
+	} {
+		if got := numberPattern(test.value); got != test.output {
+			t.Errorf("numberPattern(%d) = %q; want %q", test.value.value, got, test.output)
+		}
+	}
+}
@@ -0,0 +1,17 @@
+// Copyright 2014 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build go1.5
+
+//Enumer is a tool to generate Go code that adds useful methods to Go enums (constants with a specific type).
+//This is a fork of http://github.com/alvaroloes/enumer, which started as a fork of Rob Pike’s Stringer tool
+//
+//Please visit http://github.com/SpectraLogic/enumer for comprehensive documentation
+package main
+
+import "github.com/spectralogic/enumer/cli"
+
+func main() {
+	cli.Main()
+}
@@ -0,0 +1,159 @@
+// The Null<Type> wrapper, generated with -null, and the decoders it
+// overrides: each of them must set or clear Valid.
+
+package main
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+)
+
+type Nullable int
+
+const (
+	Red Nullable = iota
+	Green
+)
+
+type doc struct {
+	XMLName xml.Name     `xml:"doc"`
+	Attr    NullNullable `xml:"attr,attr"`
+	Elem    NullNullable `xml:"elem"`
+	Plain   Nullable     `xml:"plain"`
+}
+
+type object struct {
+	JSON NullNullable   `json:"json"`
+	List []NullNullable `json:"list"`
+}
+
+var (
+	valid   = NullNullable{Nullable: Green, Valid: true}
+	invalid = NullNullable{}
+	// A stale value, which decoding null must clear.
+	stale = NullNullable{Nullable: Green, Valid: true}
+)
+
+func main() {
+	ckSQL()
+	ckJSON()
+	ckText()
+	ckBinary()
+	ckXML()
+}
+
+func ckSQL() {
+	for _, test := range []struct {
+		value interface{}
+		n     NullNullable
+	}{
+		{nil, invalid},
+		{"Green", valid},
+		{[]byte("Green"), valid},
+		{int64(1), valid},
+	} {
+		n := stale
+		if err := n.Scan(test.value); err != nil || n != test.n {
+			panic(fmt.Sprintf("nullable.go: Scan(%v) gave %v, %v", test.value, n, err))
+		}
+		if v, err := n.Value(); err != nil || (n.Valid && v != "Green") || (!n.Valid && v != nil) {
+			panic(fmt.Sprintf("nullable.go: Value() of %v gave %v, %v", n, v, err))
+		}
+	}
+	for _, value := range []interface{}{"Blue", int64(2), 1.5} {
+		n := stale
+		if err := n.Scan(value); err == nil {
+			panic(fmt.Sprintf("nullable.go: Scan(%v) gave %v", value, n))
+		}
+	}
+}
+
+func ckJSON() {
+	data, err := json.Marshal(object{JSON: valid, List: []NullNullable{invalid, valid}})
+	if err != nil || string(data) != `{"json":"Green","list":[null,"Green"]}` {
+		panic(fmt.Sprintf("nullable.go: json.Marshal gave %s, %v", data, err))
+	}
+	d := object{JSON: stale}
+	if err := json.Unmarshal([]byte(`{"json":null,"list":[null,"Green"]}`), &d); err != nil {
+		panic("nullable.go: json.Unmarshal: " + err.Error())
+	}
+	if d.JSON != invalid || len(d.List) != 2 || d.List[0] != invalid || d.List[1] != valid {
+		panic(fmt.Sprintf("nullable.go: json.Unmarshal gave %v", d))
+	}
+	for _, data := range []string{`"Blue"`, `1`, `""`, `{}`} {
+		n := stale
+		if err := json.Unmarshal([]byte(data), &n); err == nil {
+			panic(fmt.Sprintf("nullable.go: json.Unmarshal(%s) gave %v", data, n))
+		}
+	}
+}
+
+func ckText() {
+	for _, n := range []NullNullable{invalid, valid} {
+		text, err := n.MarshalText()
+		if err != nil {
+			panic("nullable.go: MarshalText: " + err.Error())
+		}
+		got := stale
+		if err := got.UnmarshalText(text); err != nil || got != n {
+			panic(fmt.Sprintf("nullable.go: text round trip of %v gave %v, %v", n, got, err))
+		}
+	}
+	n := stale
+	if err := n.UnmarshalText([]byte("Blue")); err == nil {
+		panic(fmt.Sprintf("nullable.go: UnmarshalText(Blue) gave %v", n))
+	}
+}
+
+func ckBinary() {
+	for _, n := range []NullNullable{invalid, valid} {
+		data, err := n.MarshalBinary()
+		if err != nil {
+			panic("nullable.go: MarshalBinary: " + err.Error())
+		}
+		if !n.Valid && len(data) != 0 {
+			panic(fmt.Sprintf("nullable.go: MarshalBinary of null gave %x", data))
+		}
+		got := stale
+		if err := got.UnmarshalBinary(data); err != nil || got != n {
+			panic(fmt.Sprintf("nullable.go: binary round trip of %v gave %v, %v", n, got, err))
+		}
+	}
+	for _, data := range [][]byte{{0x80}, {4}, {2, 0}} {
+		n := stale
+		if err := n.UnmarshalBinary(data); err == nil {
+			panic(fmt.Sprintf("nullable.go: UnmarshalBinary(%x) gave %v", data, n))
+		}
+	}
+}
+
+func ckXML() {
+	for _, test := range []struct {
+		d   doc
+		xml string
+	}{
+		{doc{}, `<doc><plain>Red</plain></doc>`},
+		{doc{Attr: valid, Elem: valid, Plain: Green}, `<doc attr="Green"><elem>Green</elem><plain>Green</plain></doc>`},
+	} {
+		data, err := xml.Marshal(test.d)
+		if err != nil || string(data) != test.xml {
+			panic(fmt.Sprintf("nullable.go: xml.Marshal gave %s, %v; expected %s", data, err, test.xml))
+		}
+		var d doc
+		if err := xml.Unmarshal(data, &d); err != nil || d.Attr != test.d.Attr || d.Elem != test.d.Elem || d.Plain != test.d.Plain {
+			panic(fmt.Sprintf("nullable.go: xml round trip of %s gave %v, %v", data, d, err))
+		}
+	}
+	for _, data := range []string{
+		`<doc attr="Blue"></doc>`,
+		`<doc><elem>Blue</elem></doc>`,
+		`<doc><elem></elem></doc>`,
+		`<doc><plain>Blue</plain></doc>`,
+	} {
+		var d doc
+		if err := xml.Unmarshal([]byte(data), &d); err == nil {
+			panic(fmt.Sprintf("nullable.go: xml.Unmarshal(%s) gave %v", data, d))
+		}
+	}
+}
@@ -0,0 +1,96 @@
+// PostgreSQL arrays, generated with -pgarray and -null, of values whose names
+// need quoting and escaping. The names come from the line comments.
+
+package main
+
+import (
+	"fmt"
+)
+
+type Pgarray int
+
+const (
+	Plain     Pgarray = iota // plain
+	Space                    // c d
+	Comma                    // a,b
+	Quote                    // q"x
+	Backslash                // b\s
+	Null                     // NULL
+)
+
+func main() {
+	all := PgarraySlice{Plain, Space, Comma, Quote, Backslash, Null}
+	ckValue(all, `{"plain","c d","a,b","q\"x","b\\s","NULL"}`)
+	ckValue(PgarraySlice{}, "{}")
+	if v, err := PgarraySlice(nil).Value(); v != nil || err != nil {
+		panic(fmt.Sprintf("pgarray.go: Value() of a nil slice gave %v, %v", v, err))
+	}
+	if _, err := (PgarraySlice{Pgarray(42)}).Value(); err == nil {
+		panic("pgarray.go: Value() of an invalid element succeeded")
+	}
+
+	// The quoted form, as PostgreSQL writes it, and the unquoted one, with
+	// backslash escapes and whitespace around the elements.
+	ckScan(`{"plain","c d","a,b","q\"x","b\\s","NULL"}`, all)
+	ckScan([]byte(`{ plain ,c d, a\,b ,q\"x,b\\s,\NULL}`), all)
+	ckScan(`{ "plain" , plai\n }`, PgarraySlice{Plain, Plain})
+	ckScan("{}", PgarraySlice{})
+	var s PgarraySlice
+	if err := s.Scan(nil); err != nil || s != nil {
+		panic(fmt.Sprintf("pgarray.go: Scan(nil) gave %v, %v", s, err))
+	}
+	for _, str := range []string{
+		"", "{", "}", "plain", "{plain", "plain}",
+		"{plain,}", "{,plain}", "{plain,,plain}", "{ }x",
+		`{"plain}`, `{"plain"x}`, `{"plain" "c d"}`, `{pl"ain}`,
+		"{{plain}}", "{plain}}", `{plain\}`,
+	} {
+		if err := s.Scan(str); err == nil {
+			panic(fmt.Sprintf("pgarray.go: Scan(%q) gave %v", str, s))
+		}
+	}
+	for _, value := range []interface{}{"{nope}", "{plain,NULL}", "{plain,null}", int64(1)} {
+		if err := s.Scan(value); err == nil {
+			panic(fmt.Sprintf("pgarray.go: Scan(%v) gave %v", value, s))
+		}
+	}
+
+	nulls := NullPgarraySlice{{Pgarray: Comma, Valid: true}, {}, {Pgarray: Null, Valid: true}}
+	ckNullValue(nulls, `{"a,b",NULL,"NULL"}`)
+	ckNullScan(`{"a,b",NULL,"NULL"}`, nulls)
+	ckNullScan(`{a\,b,null,\NULL}`, nulls)
+	var ns NullPgarraySlice
+	for _, str := range []string{"{nope}", "{plain,,NULL}", `{"NULL}`} {
+		if err := ns.Scan(str); err == nil {
+			panic(fmt.Sprintf("pgarray.go: null Scan(%q) gave %v", str, ns))
+		}
+	}
+}
+
+func ckValue(s PgarraySlice, str string) {
+	v, err := s.Value()
+	if err != nil || v != str {
+		panic(fmt.Sprintf("pgarray.go: Value() of %v gave %v, %v; expected %s", s, v, err, str))
+	}
+}
+
+func ckScan(value interface{}, s PgarraySlice) {
+	var got PgarraySlice
+	if err := got.Scan(value); err != nil || fmt.Sprintf("%q", got) != fmt.Sprintf("%q", s) {
+		panic(fmt.Sprintf("pgarray.go: Scan(%s) gave %q, %v; expected %q", value, got, err, s))
+	}
+}
+
+func ckNullValue(s NullPgarraySlice, str string) {
+	v, err := s.Value()
+	if err != nil || v != str {
+		panic(fmt.Sprintf("pgarray.go: Value() of %v gave %v, %v; expected %s", s, v, err, str))
+	}
+}
+
+func ckNullScan(str string, s NullPgarraySlice) {
+	var got NullPgarraySlice
+	if err := got.Scan(str); err != nil || fmt.Sprint(got) != fmt.Sprint(s) {
+		panic(fmt.Sprintf("pgarray.go: null Scan(%s) gave %v, %v; expected %v", str, got, err, s))
+	}
+}
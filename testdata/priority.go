@@ -0,0 +1,55 @@
+// Enumeration generated with -enum, used through the generic functions of
+// the enum package. The constants are not declared in the order of their
+// values.
+
+package main
+
+import (
+	"fmt"
+
+	"github.com/spectralogic/enumer/enum"
+)
+
+type Priority int
+
+const (
+	High   Priority = 3
+	Low    Priority = 1
+	Medium Priority = 2
+)
+
+func main() {
+	ck(Low, "Low")
+	ck(Medium, "Medium")
+	ck(High, "High")
+	ck(0, "Priority(0)")
+	ckParse("Medium", Medium)
+	ckValues(enum.Values[Priority](), Low, Medium, High)
+	if _, err := enum.Parse[Priority]("Urgent"); err == nil {
+		panic("priority.go: Parse(Urgent) succeeded")
+	}
+	if !High.IsValid() || Priority(4).IsValid() {
+		panic("priority.go: IsValid")
+	}
+	var zero Priority
+	ckValues(zero.Descriptor().Values(), Low, Medium, High)
+}
+
+func ck(priority Priority, str string) {
+	if fmt.Sprint(priority) != str {
+		panic("priority.go: " + str)
+	}
+}
+
+func ckParse(s string, priority Priority) {
+	p, err := enum.Parse[Priority](s)
+	if err != nil || p != priority {
+		panic("priority.go: Parse(" + s + ")")
+	}
+}
+
+func ckValues(values []Priority, priorities ...Priority) {
+	if fmt.Sprint(values) != fmt.Sprint(priorities) {
+		panic("priority.go: Values() = " + fmt.Sprint(values))
+	}
+}
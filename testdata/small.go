@@ -0,0 +1,70 @@
+// Enumerations of types narrower than the numbers their methods decode,
+// which must reject the numbers that don't convert back exactly.
+
+package main
+
+import (
+	"encoding/binary"
+	"fmt"
+)
+
+type Small int8
+
+const (
+	A Small = iota
+	B
+	C
+)
+
+type Usmall uint8
+
+const (
+	UA Usmall = iota
+	UB
+)
+
+func main() {
+	ck(A, "A")
+	ck(C, "C")
+	ckU(UB, "UB")
+
+	for _, s := range []Small{A, B, C} {
+		data, err := s.MarshalBinary()
+		if err != nil {
+			panic("small.go: MarshalBinary: " + err.Error())
+		}
+		var got Small
+		if err := got.UnmarshalBinary(data); err != nil || got != s {
+			panic(fmt.Sprintf("small.go: binary round trip of %s gave %s, %v", s, got, err))
+		}
+	}
+	var s Small
+	// 257 converts to B.
+	for _, data := range [][]byte{binary.AppendVarint(nil, 257), binary.AppendVarint(nil, 3), {}, {0x80}, {0, 0}} {
+		if err := s.UnmarshalBinary(data); err == nil {
+			panic(fmt.Sprintf("small.go: UnmarshalBinary(%x) gave %s", data, s))
+		}
+	}
+	var u Usmall
+	// 256 converts to UA.
+	for _, data := range [][]byte{binary.AppendUvarint(nil, 256), binary.AppendUvarint(nil, 2)} {
+		if err := u.UnmarshalBinary(data); err == nil {
+			panic(fmt.Sprintf("small.go: UnmarshalBinary(%x) gave %s", data, u))
+		}
+	}
+	if err := u.UnmarshalBinary(binary.AppendUvarint(nil, 1)); err != nil || u != UB {
+		panic(fmt.Sprintf("small.go: UnmarshalBinary(1) gave %s, %v", u, err))
+	}
+}
+
+func ck(small Small, str string) {
+	if fmt.Sprint(small) != str {
+		panic("small.go: " + str)
+	}
+}
+
+func ckU(small Usmall, str string) {
+	if fmt.Sprint(small) != str {
+		panic("small.go: " + str)
+	}
+}
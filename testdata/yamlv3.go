@@ -0,0 +1,84 @@
+// Enumeration decoded with gopkg.in/yaml.v3, with numbers allowed, of a type
+// narrower than the numbers YAML holds, and its Null<Type> wrapper.
+
+package main
+
+import (
+	"fmt"
+
+	"gopkg.in/yaml.v3"
+)
+
+type Small int8
+
+const (
+	A Small = iota
+	B
+	C
+)
+
+func main() {
+	ck(A, "A")
+	ck(C, "C")
+	ckYAML("B", B)
+	ckYAML("C", C)
+	ckYAML("1", B)
+	ckYAML("'2'", C)
+	// 257 converts to B.
+	for _, text := range []string{"257", "-1", "3", "D", "[A]", "{a: b}"} {
+		var s Small
+		if err := yaml.Unmarshal([]byte(text), &s); err == nil {
+			panic(fmt.Sprintf("yamlv3.go: Unmarshal(%s) gave %s", text, s))
+		}
+	}
+	data, err := yaml.Marshal(C)
+	if err != nil || string(data) != "C\n" {
+		panic(fmt.Sprintf("yamlv3.go: Marshal(C) gave %q, %v", data, err))
+	}
+	ckNull()
+}
+
+type doc struct {
+	Value   NullSmall  `yaml:"value"`
+	Pointer *NullSmall `yaml:"pointer"`
+}
+
+func ckNull() {
+	data, err := yaml.Marshal(doc{Pointer: &NullSmall{Small: B, Valid: true}})
+	if err != nil || string(data) != "value: null\npointer: B\n" {
+		panic(fmt.Sprintf("yamlv3.go: Marshal of nulls gave %q, %v", data, err))
+	}
+	var d doc
+	if err := yaml.Unmarshal(data, &d); err != nil || d.Value.Valid || d.Pointer == nil || *d.Pointer != (NullSmall{Small: B, Valid: true}) {
+		panic(fmt.Sprintf("yamlv3.go: Unmarshal(%s) gave %v, %v", data, d, err))
+	}
+	// yaml.v3 leaves a Null<Type> untouched for null, but sets a pointer to
+	// one to nil.
+	stale := NullSmall{Small: C, Valid: true}
+	d = doc{Value: stale, Pointer: &stale}
+	if err := yaml.Unmarshal([]byte("value: null\npointer: null\n"), &d); err != nil || d.Value != stale || d.Pointer != nil {
+		panic(fmt.Sprintf("yamlv3.go: Unmarshal of nulls gave %v, %v", d, err))
+	}
+	d = doc{}
+	if err := yaml.Unmarshal([]byte("value: 2\n"), &d); err != nil || d.Value != (NullSmall{Small: C, Valid: true}) {
+		panic(fmt.Sprintf("yamlv3.go: Unmarshal(value: 2) gave %v, %v", d, err))
+	}
+	for _, text := range []string{"value: 3", "value: D", "value: [A]"} {
+		if err := yaml.Unmarshal([]byte(text), &d); err == nil {
+			panic(fmt.Sprintf("yamlv3.go: Unmarshal(%s) gave %v", text, d))
+		}
+	}
+}
+
+func ck(small Small, str string) {
+	if fmt.Sprint(small) != str {
+		panic("yamlv3.go: " + str)
+	}
+}
+
+func ckYAML(text string, small Small) {
+	var s Small
+	if err := yaml.Unmarshal([]byte(text), &s); err != nil || s != small {
+		panic(fmt.Sprintf("yamlv3.go: Unmarshal(%s) gave %s, %v", text, s, err))
+	}
+}